@@ -0,0 +1,74 @@
+package ghbot
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// actionEventTypes maps the workflow trigger names whose payloads are
+// those of another webhook event type.
+var actionEventTypes = map[string]string{
+	"pull_request_target": "pull_request",
+}
+
+// RunAction dispatches the single event which triggered the GitHub Actions
+// job it runs in, read from GITHUB_EVENT_NAME and GITHUB_EVENT_PATH,
+// through the registered hooks, as if it had been delivered as a webhook.
+// The injected client is authenticated with GITHUB_TOKEN and talks to
+// GITHUB_API_URL when they are set, and is created by the bot otherwise.
+// The returned error tells about the first hook which failed, so that
+//
+//	if err := bot.RunAction(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// fails the job.
+func (bot *Bot) RunAction(ctx context.Context) error {
+	return bot.runAction(ctx, os.Getenv)
+}
+
+func (bot *Bot) runAction(ctx context.Context, getenv func(string) string) error {
+	if err := bot.Validate(); err != nil {
+		return xerrors.Errorf("invalid configuration: %w", err)
+	}
+	typ := getenv("GITHUB_EVENT_NAME")
+	if typ == "" {
+		return xerrors.New("GITHUB_EVENT_NAME is not set; not running in GitHub Actions?")
+	}
+	if t, ok := actionEventTypes[typ]; ok {
+		typ = t
+	}
+	path := getenv("GITHUB_EVENT_PATH")
+	if path == "" {
+		return xerrors.New("GITHUB_EVENT_PATH is not set; not running in GitHub Actions?")
+	}
+	payload, err := ioutil.ReadFile(path)
+	if err != nil {
+		return xerrors.Errorf("error reading event payload: %w", err)
+	}
+
+	if token := getenv("GITHUB_TOKEN"); token != "" {
+		client := bot.newGitHubClient(func(base http.RoundTripper) http.RoundTripper {
+			return &tokenTransport{token: token, base: base}
+		})
+		// GITHUB_API_URL is https://api.github.com on github.com, which
+		// the client talks to by default
+		if apiURL := getenv("GITHUB_API_URL"); apiURL != "" && strings.TrimSuffix(apiURL, "/") != strings.TrimSuffix(client.BaseURL.String(), "/") {
+			baseURL, uploadURL, err := enterpriseURLs(apiURL, "")
+			if err != nil {
+				return xerrors.Errorf("invalid GITHUB_API_URL: %w", err)
+			}
+			client.BaseURL, client.UploadURL = baseURL, uploadURL
+		}
+		ctx = WithClient(ctx, client)
+	}
+	if err := bot.handleWebHookPayload(ctx, typ, payload); err != nil {
+		return xerrors.Errorf("error handling %s event: %w", typ, err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package ghbot
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+func TestRunAction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ghbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	eventPath := filepath.Join(dir, "event.json")
+	payload := `{"action":"opened","number":1,"pull_request":{"number":1},"repository":{"full_name":"octocat/hello-world"}}`
+	if err := ioutil.WriteFile(eventPath, []byte(payload), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v3/repos/octocat/hello-world" {
+			authorization = r.Header.Get("Authorization")
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	env := map[string]string{
+		"GITHUB_EVENT_NAME": "pull_request_target",
+		"GITHUB_EVENT_PATH": eventPath,
+		"GITHUB_TOKEN":      "secret",
+		"GITHUB_API_URL":    srv.URL + "/api/v3",
+	}
+	getenv := func(key string) string { return env[key] }
+
+	bot := New(Config{})
+	var client *github.Client
+	bot.AddPullRequestEventHook(func(ctx context.Context, e *github.PullRequestEvent) error {
+		client = ClientFromContext(ctx)
+		_, _, err := client.Repositories.Get(ctx, "octocat", "hello-world")
+		return err
+	})
+	if err := bot.runAction(context.Background(), getenv); err != nil {
+		t.Fatal(err)
+	}
+	if client == nil {
+		t.Fatal("pull_request_target event did not run pull_request hooks")
+	}
+	if got, want := client.BaseURL.String(), srv.URL+"/api/v3/"; got != want {
+		t.Errorf("BaseURL = %s, want %s", got, want)
+	}
+	if got, want := client.UploadURL.String(), srv.URL+"/api/uploads/"; got != want {
+		t.Errorf("UploadURL = %s, want %s", got, want)
+	}
+	if authorization != "token secret" {
+		t.Errorf("Authorization = %q, want the GITHUB_TOKEN", authorization)
+	}
+
+	// a failing hook fails the job
+	errHook := xerrors.New("hook failed")
+	bot.AddPullRequestEventHook(func(context.Context, *github.PullRequestEvent) error { return errHook })
+	if err := bot.runAction(context.Background(), getenv); !xerrors.Is(err, errHook) {
+		t.Errorf("runAction returned %v, want the error of the hook", err)
+	}
+
+	for _, key := range []string{"GITHUB_EVENT_NAME", "GITHUB_EVENT_PATH"} {
+		value := env[key]
+		delete(env, key)
+		if err := bot.runAction(context.Background(), getenv); err == nil {
+			t.Errorf("no error without %s", key)
+		}
+		env[key] = value
+	}
+}
+
+func TestRunActionGitHubCom(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ghbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	eventPath := filepath.Join(dir, "event.json")
+	if err := ioutil.WriteFile(eventPath, []byte(`{"ref":"refs/heads/master"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	env := map[string]string{
+		"GITHUB_EVENT_NAME": "push",
+		"GITHUB_EVENT_PATH": eventPath,
+		"GITHUB_TOKEN":      "secret",
+		"GITHUB_API_URL":    "https://api.github.com",
+	}
+
+	bot := New(Config{})
+	var client *github.Client
+	bot.AddPushEventHook(func(ctx context.Context, e *github.PushEvent) error {
+		client = ClientFromContext(ctx)
+		return nil
+	})
+	if err := bot.runAction(context.Background(), func(key string) string { return env[key] }); err != nil {
+		t.Fatal(err)
+	}
+	if client.BaseURL.String() != "https://api.github.com/" || client.UploadURL.String() != "https://uploads.github.com/" {
+		t.Errorf("client talks to %s and %s", client.BaseURL, client.UploadURL)
+	}
+}
@@ -0,0 +1,224 @@
+package ghbot
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// GitHub rejects JWTs which live longer than 10 minutes. iat is
+	// backdated to allow for clock drift.
+	appJWTLifetime = 9 * time.Minute
+	appJWTBackdate = time.Minute
+	// installation tokens are refreshed when they expire within this
+	// margin, so that a token never expires in the middle of a hook.
+	installationTokenMargin = 5 * time.Minute
+)
+
+// loadPrivateKey reads the PEM encoded private key of a GitHub App from
+// cfg.
+func loadPrivateKey(cfg Config) (*rsa.PrivateKey, error) {
+	data := cfg.PrivateKey
+	if len(data) == 0 {
+		if cfg.PrivateKeyFile == "" {
+			return nil, xerrors.New("GitHub App needs PrivateKey or PrivateKeyFile")
+		}
+		b, err := ioutil.ReadFile(cfg.PrivateKeyFile)
+		if err != nil {
+			return nil, xerrors.Errorf("error reading private key: %w", err)
+		}
+		data = b
+	}
+	return ParsePrivateKey(data)
+}
+
+// ParsePrivateKey parses a PEM encoded RSA private key in PKCS #1 or
+// PKCS #8 form, as downloaded from the settings of a GitHub App.
+func ParsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, xerrors.New("no PEM block found in private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, xerrors.Errorf("error parsing private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, xerrors.New("private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// signAppJWT returns an RS256 signed JWT which authenticates as the
+// GitHub App, and when it expires.
+func signAppJWT(appID int64, key *rsa.PrivateKey, now time.Time) (string, time.Time, error) {
+	exp := now.Add(appJWTLifetime)
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-appJWTBackdate).Unix(),
+		"exp": exp.Unix(),
+		"iss": strconv.FormatInt(appID, 10),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	enc := base64.RawURLEncoding
+	signingInput := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", time.Time{}, xerrors.Errorf("error signing JWT: %w", err)
+	}
+	return signingInput + "." + enc.EncodeToString(sig), exp, nil
+}
+
+// appAuth authenticates as a GitHub App and hands out cached installation
+// tokens.
+type appAuth struct {
+	appID int64
+	key   *rsa.PrivateKey
+	now   func() time.Time
+
+	mu         sync.Mutex
+	jwt        string
+	jwtExpires time.Time
+	tokens     map[int64]*github.InstallationToken
+	// appClient is authenticated with the JWT and used to exchange it
+	// for installation tokens.
+	appClient *github.Client
+	clients   map[int64]*github.Client
+	newClient func(auth func(base http.RoundTripper) http.RoundTripper) *github.Client
+}
+
+func (a *appAuth) appJWT() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	if a.jwt != "" && now.Add(time.Minute).Before(a.jwtExpires) {
+		return a.jwt, nil
+	}
+	jwt, exp, err := signAppJWT(a.appID, a.key, now)
+	if err != nil {
+		return "", err
+	}
+	a.jwt, a.jwtExpires = jwt, exp
+	return jwt, nil
+}
+
+// installationToken returns a token of the installation which is valid
+// for at least installationTokenMargin, exchanging a new one if needed.
+func (a *appAuth) installationToken(ctx context.Context, installationID int64) (string, error) {
+	a.mu.Lock()
+	tok, ok := a.tokens[installationID]
+	a.mu.Unlock()
+	if ok && a.now().Add(installationTokenMargin).Before(tok.GetExpiresAt()) {
+		return tok.GetToken(), nil
+	}
+
+	tok, _, err := a.appClient.Apps.CreateInstallationToken(ctx, installationID)
+	if err != nil {
+		return "", xerrors.Errorf("error creating token of installation %d: %w", installationID, err)
+	}
+	a.mu.Lock()
+	a.tokens[installationID] = tok
+	a.mu.Unlock()
+	return tok.GetToken(), nil
+}
+
+// client returns the client of the installation, or the client which
+// authenticates as the App itself when installationID is 0.
+func (a *appAuth) client(installationID int64) *github.Client {
+	if installationID == 0 {
+		return a.appClient
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if client, ok := a.clients[installationID]; ok {
+		return client
+	}
+	client := a.newClient(func(base http.RoundTripper) http.RoundTripper {
+		return &installationTransport{
+			app:            a,
+			installationID: installationID,
+			base:           base,
+		}
+	})
+	a.clients[installationID] = client
+	return client
+}
+
+// appTransport authenticates requests as the GitHub App with a JWT.
+type appTransport struct {
+	app  *appAuth
+	base http.RoundTripper
+}
+
+func (t *appTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	jwt, err := t.app.appJWT()
+	if err != nil {
+		return nil, err
+	}
+	return roundTripWithAuthorization(t.base, req, "Bearer "+jwt, "app")
+}
+
+// installationTransport authenticates requests with an installation
+// token.
+type installationTransport struct {
+	app            *appAuth
+	installationID int64
+	base           http.RoundTripper
+}
+
+func (t *installationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.app.installationToken(req.Context(), t.installationID)
+	if err != nil {
+		return nil, err
+	}
+	return roundTripWithAuthorization(t.base, req, "token "+token, "installation:"+strconv.FormatInt(t.installationID, 10))
+}
+
+func (bot *Bot) newAppAuth(cfg Config) (*appAuth, error) {
+	key, err := loadPrivateKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+	app := appAuth{
+		appID:     cfg.AppID,
+		key:       key,
+		now:       time.Now,
+		tokens:    map[int64]*github.InstallationToken{},
+		clients:   map[int64]*github.Client{},
+		newClient: bot.newGitHubClient,
+	}
+	app.appClient = bot.newGitHubClient(func(base http.RoundTripper) http.RoundTripper {
+		return &appTransport{app: &app, base: base}
+	})
+	return &app, nil
+}
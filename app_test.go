@@ -0,0 +1,217 @@
+package ghbot
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+)
+
+var testAppKey *rsa.PrivateKey
+
+func appKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	if testAppKey == nil {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testAppKey = key
+	}
+	return testAppKey
+}
+
+// verifyAppJWT checks the signature of jwt and returns its header and
+// claims.
+func verifyAppJWT(t *testing.T, jwt string, key *rsa.PublicKey) (map[string]string, map[string]interface{}) {
+	t.Helper()
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		t.Fatalf("malformed JWT %q", jwt)
+	}
+	enc := base64.RawURLEncoding
+	sig, err := enc.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		t.Fatalf("invalid JWT signature: %v", err)
+	}
+	var header map[string]string
+	var claims map[string]interface{}
+	for i, v := range []interface{}{&header, &claims} {
+		b, err := enc.DecodeString(parts[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(b, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return header, claims
+}
+
+func TestSignAppJWT(t *testing.T) {
+	key := appKey(t)
+	now := time.Unix(1500000000, 0)
+	jwt, exp, err := signAppJWT(42, key, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := now.Add(appJWTLifetime); !exp.Equal(want) {
+		t.Errorf("expires at %s, want %s", exp, want)
+	}
+	header, claims := verifyAppJWT(t, jwt, &key.PublicKey)
+	if header["alg"] != "RS256" || header["typ"] != "JWT" {
+		t.Errorf("header = %v", header)
+	}
+	if claims["iss"] != "42" {
+		t.Errorf("iss = %v, want \"42\"", claims["iss"])
+	}
+	if iat := claims["iat"]; iat != float64(now.Add(-appJWTBackdate).Unix()) {
+		t.Errorf("iat = %v, want %d", iat, now.Add(-appJWTBackdate).Unix())
+	}
+	if exp := claims["exp"]; exp != float64(now.Add(appJWTLifetime).Unix()) {
+		t.Errorf("exp = %v, want %d", exp, now.Add(appJWTLifetime).Unix())
+	}
+}
+
+// fakeApp serves the installation token endpoint and a repository
+// endpoint, which records the Authorization it was called with.
+type fakeApp struct {
+	t   *testing.T
+	key *rsa.PublicKey
+	now func() time.Time
+
+	mu            sync.Mutex
+	issued        map[int64]int
+	authorization string
+}
+
+func (f *fakeApp) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var id int64
+	if _, err := fmt.Sscanf(r.URL.Path, "/api/v3/app/installations/%d/access_tokens", &id); err == nil {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		jwt := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if _, claims := verifyAppJWT(f.t, jwt, f.key); claims["iss"] != "42" {
+			f.t.Errorf("token requested with iss %v", claims["iss"])
+		}
+		f.issued[id]++
+		w.WriteHeader(http.StatusCreated)
+		expires := f.now().Add(time.Hour)
+		json.NewEncoder(w).Encode(github.InstallationToken{
+			Token:     github.String(fmt.Sprintf("token-%d-%d", id, f.issued[id])),
+			ExpiresAt: &expires,
+		})
+		return
+	}
+	f.authorization = r.Header.Get("Authorization")
+	fmt.Fprint(w, `{"full_name":"octocat/hello-world"}`)
+}
+
+func TestInstallationToken(t *testing.T) {
+	key := appKey(t)
+	var clockMu sync.Mutex
+	clock := time.Now()
+	now := func() time.Time {
+		clockMu.Lock()
+		defer clockMu.Unlock()
+		return clock
+	}
+
+	app := &fakeApp{t: t, key: &key.PublicKey, now: now, issued: map[int64]int{}}
+	srv := httptest.NewServer(app)
+	defer srv.Close()
+
+	bot := New(Config{
+		AppID:             42,
+		PrivateKey:        pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		EnterpriseBaseURL: srv.URL + "/api/v3/",
+	})
+	if err := bot.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	bot.app.now = now
+
+	// the client handed to hooks is the one of the installation the
+	// event was delivered for
+	var authorization string
+	bot.AddPushEventHook(func(ctx context.Context, e *github.PushEvent) error {
+		if _, _, err := ClientFromContext(ctx).Repositories.Get(ctx, "octocat", "hello-world"); err != nil {
+			return err
+		}
+		app.mu.Lock()
+		defer app.mu.Unlock()
+		authorization = app.authorization
+		return nil
+	})
+	deliver := func(installationID int64) string {
+		t.Helper()
+		payload := fmt.Sprintf(`{"ref":"refs/heads/master","installation":{"id":%d}}`, installationID)
+		if err := bot.handleWebHookPayload(context.Background(), "push", []byte(payload)); err != nil {
+			t.Fatal(err)
+		}
+		return authorization
+	}
+
+	for _, tt := range []struct {
+		installationID int64
+		advance        time.Duration
+		want           string
+	}{
+		{1, 0, "token token-1-1"},
+		// tokens are cached per installation
+		{1, 0, "token token-1-1"},
+		{2, 0, "token token-2-1"},
+		{1, 54 * time.Minute, "token token-1-1"},
+		// and refreshed once they expire within the margin
+		{1, 2 * time.Minute, "token token-1-2"},
+		{1, 0, "token token-1-2"},
+	} {
+		clockMu.Lock()
+		clock = clock.Add(tt.advance)
+		clockMu.Unlock()
+		if got := deliver(tt.installationID); got != tt.want {
+			t.Errorf("installation %d authorized with %q, want %q", tt.installationID, got, tt.want)
+		}
+	}
+}
+
+func TestInstallationID(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    int64
+	}{
+		{`{"action":"opened","installation":{"id":7,"node_id":"MDIz"}}`, 7},
+		{`{"action":"opened"}`, 0},
+		{`{"installation":null}`, 0},
+		{`not json`, 0},
+	}
+	for _, tt := range tests {
+		if got := payloadInstallationID([]byte(tt.payload)); got != tt.want {
+			t.Errorf("payloadInstallationID(%s) = %d, want %d", tt.payload, got, tt.want)
+		}
+	}
+
+}
@@ -0,0 +1,274 @@
+package ghbot
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/xerrors"
+)
+
+// defaultResponseCacheSize is the number of responses the cache of a new
+// bot holds.
+const defaultResponseCacheSize = 1000
+
+// ResponseCache stores responses of GitHub API reads, so that they can be
+// revalidated with conditional requests. Responses are stored in wire
+// format. Get returns nil without error when key is not cached.
+type ResponseCache interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, resp []byte) error
+}
+
+// CacheStats counts the reads which went through the response cache.
+type CacheStats struct {
+	// Requests is the number of cacheable requests.
+	Requests int64
+	// Hits is the number of requests answered from the cache after
+	// GitHub replied 304 Not Modified.
+	Hits int64
+	// Errors is the number of failed cache reads and writes. Requests are
+	// sent without the cache when it fails.
+	Errors int64
+}
+
+// HitRate returns the fraction of requests answered from the cache.
+func (s CacheStats) HitRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Requests)
+}
+
+// MemoryResponseCache keeps the most recently used responses in memory.
+type MemoryResponseCache struct {
+	mu      sync.Mutex
+	size    int
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key  string
+	resp []byte
+}
+
+// NewMemoryResponseCache returns a cache holding up to size responses.
+func NewMemoryResponseCache(size int) *MemoryResponseCache {
+	return &MemoryResponseCache{
+		size:    size,
+		lru:     list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+func (c *MemoryResponseCache) Get(_ context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, nil
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*memoryCacheEntry).resp, nil
+}
+
+func (c *MemoryResponseCache) Set(_ context.Context, key string, resp []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*memoryCacheEntry).resp = resp
+		c.lru.MoveToFront(elem)
+		return nil
+	}
+	c.entries[key] = c.lru.PushFront(&memoryCacheEntry{key: key, resp: resp})
+	for c.size > 0 && c.lru.Len() > c.size {
+		elem := c.lru.Back()
+		c.lru.Remove(elem)
+		delete(c.entries, elem.Value.(*memoryCacheEntry).key)
+	}
+	return nil
+}
+
+// DiskResponseCache stores every response in a file of its own in a
+// directory, so that the cache survives restarts. Nothing is ever evicted:
+// the directory grows with every distinct request and credential, so
+// clear it from time to time, e.g. on startup, or use a
+// MemoryResponseCache where that matters.
+type DiskResponseCache struct {
+	dir string
+}
+
+// NewDiskResponseCache creates dir if it does not exist.
+func NewDiskResponseCache(dir string) (*DiskResponseCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, xerrors.Errorf("error creating cache directory: %w", err)
+	}
+	return &DiskResponseCache{dir: dir}, nil
+}
+
+func (c *DiskResponseCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+func (c *DiskResponseCache) Get(_ context.Context, key string) ([]byte, error) {
+	b, err := ioutil.ReadFile(c.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("error reading cached response: %w", err)
+	}
+	return b, nil
+}
+
+func (c *DiskResponseCache) Set(_ context.Context, key string, resp []byte) error {
+	if err := writeFileAtomic(c.path(key), resp); err != nil {
+		return xerrors.Errorf("error writing cached response: %w", err)
+	}
+	return nil
+}
+
+// SetResponseCache replaces the cache of the clients the bot creates.
+// nil disables caching.
+func (bot *Bot) SetResponseCache(cache ResponseCache) {
+	bot.cache.mu.Lock()
+	defer bot.cache.mu.Unlock()
+
+	bot.cache.cache = cache
+}
+
+// cacheTransport revalidates cached responses of GET requests with
+// If-None-Match and If-Modified-Since. Responses are cached per
+// credential, so that a client never sees what another one may not.
+type cacheTransport struct {
+	base http.RoundTripper
+
+	mu    sync.Mutex
+	cache ResponseCache
+	stats CacheStats
+}
+
+func (t *cacheTransport) snapshot() CacheStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.stats
+}
+
+func (t *cacheTransport) count(f func(*CacheStats)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	f(&t.stats)
+}
+
+func cacheKey(req *http.Request) string {
+	return requestCredential(req) + " " + req.Header.Get("Accept") + " " + req.URL.String()
+}
+
+func isCacheable(req *http.Request) bool {
+	return req.Method == http.MethodGet &&
+		req.Header.Get("Range") == "" &&
+		req.Header.Get("If-None-Match") == "" &&
+		req.Header.Get("If-Modified-Since") == ""
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	t.mu.Lock()
+	cache := t.cache
+	t.mu.Unlock()
+	if cache == nil || !isCacheable(req) {
+		return base.RoundTrip(req)
+	}
+	ctx := req.Context()
+	key := cacheKey(req)
+	t.count(func(s *CacheStats) { s.Requests++ })
+
+	cached, err := t.cached(ctx, cache, key, req)
+	if err != nil {
+		t.count(func(s *CacheStats) { s.Errors++ })
+	}
+	if cached != nil {
+		r := new(http.Request)
+		*r = *req
+		r.Header = make(http.Header, len(req.Header)+2)
+		for k, v := range req.Header {
+			r.Header[k] = v
+		}
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			r.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+			r.Header.Set("If-Modified-Since", lastModified)
+		}
+		req = r
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+		t.count(func(s *CacheStats) { s.Hits++ })
+		// the 304 carries the current rate limit, but the headers
+		// describing the body still belong to the cached one
+		for k, v := range resp.Header {
+			if !bodyHeaders[k] {
+				cached.Header[k] = v
+			}
+		}
+		cached.Request = resp.Request
+		return cached, nil
+	}
+	if cached != nil {
+		cached.Body.Close()
+	}
+	if resp.StatusCode == http.StatusOK && (resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != "") {
+		b, err := httputil.DumpResponse(resp, true)
+		if err != nil {
+			return nil, err
+		}
+		if err := cache.Set(ctx, key, b); err != nil {
+			t.count(func(s *CacheStats) { s.Errors++ })
+		}
+	}
+	return resp, nil
+}
+
+// bodyHeaders are the headers of a cached response a 304 does not update.
+var bodyHeaders = map[string]bool{
+	"Content-Length":    true,
+	"Content-Encoding":  true,
+	"Content-Type":      true,
+	"Transfer-Encoding": true,
+}
+
+func (t *cacheTransport) cached(ctx context.Context, cache ResponseCache, key string, req *http.Request) (*http.Response, error) {
+	b, err := cache.Get(ctx, key)
+	if err != nil || b == nil {
+		return nil, err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), req)
+	if err != nil {
+		return nil, xerrors.Errorf("error parsing cached response: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,215 @@
+package ghbot
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeCachedServer serves a resource validated by ETag and one validated
+// by Last-Modified, and records the conditional headers of the requests.
+type fakeCachedServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	remaining  int
+	conditions []string
+}
+
+func newFakeCachedServer() *fakeCachedServer {
+	s := &fakeCachedServer{remaining: 100}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.remaining--
+		s.conditions = append(s.conditions, strings.TrimSpace(r.Header.Get("If-None-Match")+" "+r.Header.Get("If-Modified-Since")))
+		w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(s.remaining))
+		switch r.URL.Path {
+		case "/etag":
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+		case "/last-modified":
+			if r.Header.Get("If-Modified-Since") == "Sat, 01 Jun 2019 00:00:00 GMT" {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("Last-Modified", "Sat, 01 Jun 2019 00:00:00 GMT")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"path":%q,"authorization":%q}`, r.URL.Path, r.Header.Get("Authorization"))
+	}))
+	return s
+}
+
+func (s *fakeCachedServer) takeConditions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conditions := s.conditions
+	s.conditions = nil
+	return conditions
+}
+
+// bodyHeaderTransport adds headers describing a body to 304s, which
+// net/http servers strip but proxies may not.
+type bodyHeaderTransport struct{}
+
+func (bodyHeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusNotModified {
+		resp.Header.Set("Content-Type", "text/plain")
+		resp.Header.Set("Content-Length", "0")
+	}
+	return resp, err
+}
+
+type cachedResponse struct {
+	status    int
+	body      string
+	remaining string
+	header    http.Header
+}
+
+func getCached(t *testing.T, rt http.RoundTripper, url, credential string, header http.Header) cachedResponse {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := roundTripWithAuthorization(rt, req, "token "+credential, "token:"+credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cachedResponse{resp.StatusCode, string(b), resp.Header.Get("X-RateLimit-Remaining"), resp.Header}
+}
+
+func TestCacheTransport(t *testing.T) {
+	srv := newFakeCachedServer()
+	defer srv.Close()
+	rt := &cacheTransport{base: bodyHeaderTransport{}, cache: NewMemoryResponseCache(10)}
+
+	for _, path := range []string{"/etag", "/last-modified"} {
+		url := srv.URL + path
+		first := getCached(t, rt, url, "a", nil)
+		second := getCached(t, rt, url, "a", nil)
+		// the 304 is answered from the cache, with the rate limit of the
+		// 304 but the headers of the body of the cached response
+		if second.status != http.StatusOK || second.body != first.body {
+			t.Errorf("%s: revalidated response = %d %s, want %s", path, second.status, second.body, first.body)
+		}
+		if first.remaining == second.remaining || second.remaining == "" {
+			t.Errorf("%s: X-RateLimit-Remaining = %s after %s, want the one of the 304", path, second.remaining, first.remaining)
+		}
+		if ct, cl := second.header.Get("Content-Type"), second.header.Get("Content-Length"); ct != "application/json" || cl != fmt.Sprint(len(first.body)) {
+			t.Errorf("%s: Content-Type = %q, Content-Length = %q", path, ct, cl)
+		}
+		want := map[string]string{"/etag": `"v1"`, "/last-modified": "Sat, 01 Jun 2019 00:00:00 GMT"}[path]
+		if conditions := srv.takeConditions(); len(conditions) != 2 || conditions[0] != "" || conditions[1] != want {
+			t.Errorf("%s: conditions = %q", path, conditions)
+		}
+
+		// responses are cached per credential
+		other := getCached(t, rt, url, "b", nil)
+		if !strings.Contains(other.body, "token b") {
+			t.Errorf("%s: another credential got %s", path, other.body)
+		}
+		if conditions := srv.takeConditions(); len(conditions) != 1 || conditions[0] != "" {
+			t.Errorf("%s: request of another credential was sent with %q", path, conditions)
+		}
+	}
+
+	// requests which are conditional already go to GitHub as they are
+	if resp := getCached(t, rt, srv.URL+"/etag", "a", http.Header{"If-None-Match": {`"v1"`}}); resp.status != http.StatusNotModified {
+		t.Errorf("conditional request got %d", resp.status)
+	}
+	// and responses without validators are not cached
+	getCached(t, rt, srv.URL+"/plain", "a", nil)
+	getCached(t, rt, srv.URL+"/plain", "a", nil)
+	if conditions := srv.takeConditions(); len(conditions) != 3 || conditions[2] != "" {
+		t.Errorf("conditions = %q", conditions)
+	}
+
+	stats := rt.snapshot()
+	if stats.Requests != 8 || stats.Hits != 2 || stats.Errors != 0 {
+		t.Errorf("stats = %+v", stats)
+	}
+	if rate := stats.HitRate(); rate != 0.25 {
+		t.Errorf("hit rate = %v, want 0.25", rate)
+	}
+	if rate := (CacheStats{}).HitRate(); rate != 0 {
+		t.Errorf("hit rate without requests = %v", rate)
+	}
+}
+
+func TestMemoryResponseCache(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemoryResponseCache(2)
+	c.Set(ctx, "a", []byte("a"))
+	c.Set(ctx, "b", []byte("b"))
+	// a is used more recently than b, which is evicted
+	c.Get(ctx, "a")
+	c.Set(ctx, "c", []byte("c"))
+	for key, want := range map[string]string{"a": "a", "b": "", "c": "c"} {
+		if b, err := c.Get(ctx, key); err != nil || string(b) != want {
+			t.Errorf("Get(%q) = %q, %v, want %q", key, b, err, want)
+		}
+	}
+}
+
+func TestDiskResponseCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ghbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+
+	c, err := NewDiskResponseCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, err := c.Get(ctx, "key"); b != nil || err != nil {
+		t.Errorf("Get of a missing key = %q, %v", b, err)
+	}
+	if err := c.Set(ctx, "key", []byte("response")); err != nil {
+		t.Fatal(err)
+	}
+
+	// the responses survive a restart
+	srv := newFakeCachedServer()
+	defer srv.Close()
+	rt := &cacheTransport{base: http.DefaultTransport, cache: c}
+	first := getCached(t, rt, srv.URL+"/etag", "a", nil)
+	reopened, err := NewDiskResponseCache(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, err := reopened.Get(ctx, "key"); string(b) != "response" || err != nil {
+		t.Errorf("Get after reopening = %q, %v", b, err)
+	}
+	rt = &cacheTransport{base: http.DefaultTransport, cache: reopened}
+	if second := getCached(t, rt, srv.URL+"/etag", "a", nil); second.body != first.body {
+		t.Errorf("revalidated response = %s, want %s", second.body, first.body)
+	}
+	if stats := rt.snapshot(); stats.Hits != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
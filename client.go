@@ -0,0 +1,197 @@
+package ghbot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+// ClientFactory creates the GitHub client handed to the hooks of a
+// delivery. installationID is the ID of the GitHub App installation the
+// event was delivered for, or 0 when the payload has none.
+type ClientFactory func(ctx context.Context, installationID int64) (*github.Client, error)
+
+type clientContextKey struct{}
+
+// ClientFromContext returns the GitHub client the bot put into a hook's
+// context, or nil when the bot has no credentials.
+func ClientFromContext(ctx context.Context) *github.Client {
+	client, _ := ctx.Value(clientContextKey{}).(*github.Client)
+	return client
+}
+
+// WithClient returns a copy of ctx carrying client, to be retrieved with
+// ClientFromContext.
+func WithClient(ctx context.Context, client *github.Client) context.Context {
+	return context.WithValue(ctx, clientContextKey{}, client)
+}
+
+// SetClientFactory replaces how the bot creates the client handed to
+// hooks, e.g. to point it at an httptest server in tests.
+func (bot *Bot) SetClientFactory(factory ClientFactory) {
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+
+	bot.clientFactory = factory
+}
+
+// defaultClientFactory returns a factory which authenticates with the
+// credentials in cfg, or nil when there are none. A GitHub App takes
+// precedence over a token; its clients are scoped to the installation of
+// the event, or authenticate as the App for events without one.
+func (bot *Bot) defaultClientFactory(cfg Config) (ClientFactory, error) {
+	if cfg.AppID != 0 {
+		app, err := bot.newAppAuth(cfg)
+		if err != nil {
+			return nil, xerrors.Errorf("error setting up GitHub App authentication: %w", err)
+		}
+		bot.app = app
+		return func(_ context.Context, installationID int64) (*github.Client, error) {
+			return app.client(installationID), nil
+		}, nil
+	}
+	if cfg.GitHubToken != "" {
+		client := bot.newGitHubClient(func(base http.RoundTripper) http.RoundTripper {
+			return &tokenTransport{token: cfg.GitHubToken, base: base}
+		})
+		return func(context.Context, int64) (*github.Client, error) {
+			return client, nil
+		}, nil
+	}
+	return nil, nil
+}
+
+// newGitHubClient creates a client whose requests are authenticated by
+// the transport auth returns, on top of the transport shared by all
+// clients of the bot.
+func (bot *Bot) newGitHubClient(auth func(base http.RoundTripper) http.RoundTripper) *github.Client {
+	client := github.NewClient(&http.Client{Transport: auth(bot.transport)})
+	if bot.baseURL != nil {
+		baseURL, uploadURL := *bot.baseURL, *bot.uploadURL
+		client.BaseURL, client.UploadURL = &baseURL, &uploadURL
+	}
+	return client
+}
+
+// setEnterpriseURLs validates and normalizes the GitHub Enterprise Server
+// URLs in cfg.
+func (bot *Bot) setEnterpriseURLs(cfg Config) error {
+	if cfg.EnterpriseBaseURL == "" && cfg.EnterpriseUploadURL == "" {
+		return nil
+	}
+	if cfg.EnterpriseBaseURL == "" {
+		return xerrors.New("EnterpriseUploadURL needs EnterpriseBaseURL")
+	}
+	baseURL, uploadURL, err := enterpriseURLs(cfg.EnterpriseBaseURL, cfg.EnterpriseUploadURL)
+	if err != nil {
+		return xerrors.Errorf("invalid GitHub Enterprise URL: %w", err)
+	}
+	bot.baseURL, bot.uploadURL = baseURL, uploadURL
+	return nil
+}
+
+// enterpriseURLs normalizes the API and upload URLs of a GitHub Enterprise
+// Server the same way github.NewEnterpriseClient does. The upload URL is
+// derived from the base URL when empty.
+func enterpriseURLs(baseURL, uploadURL string) (*url.URL, *url.URL, error) {
+	if uploadURL == "" {
+		uploadURL = strings.TrimSuffix(strings.TrimSuffix(baseURL, "/"), "/api/v3")
+		if uploadURL != strings.TrimSuffix(baseURL, "/") {
+			uploadURL += "/api/uploads/"
+		}
+	}
+	client, err := github.NewEnterpriseClient(baseURL, uploadURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.BaseURL, client.UploadURL, nil
+}
+
+// withClient puts the client for installationID into ctx unless it
+// already carries one.
+func (bot *Bot) withClient(ctx context.Context, installationID int64) (context.Context, error) {
+	if ClientFromContext(ctx) != nil {
+		return ctx, nil
+	}
+	bot.mu.Lock()
+	factory := bot.clientFactory
+	bot.mu.Unlock()
+
+	if factory == nil {
+		return ctx, nil
+	}
+	client, err := factory(ctx, installationID)
+	if err != nil {
+		return nil, err
+	}
+	return WithClient(ctx, client), nil
+}
+
+// payloadInstallationID returns the ID of the installation a raw payload
+// was delivered for.
+func payloadInstallationID(payload []byte) int64 {
+	var p struct {
+		Installation struct {
+			ID int64 `json:"id"`
+		} `json:"installation"`
+	}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return 0
+	}
+	return p.Installation.ID
+}
+
+// payloadRepository returns "owner/name" of the repository a raw payload
+// is about.
+func payloadRepository(payload []byte) string {
+	var p struct {
+		Repository struct {
+			FullName string `json:"full_name"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return ""
+	}
+	return p.Repository.FullName
+}
+
+type tokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	sum := sha256.Sum256([]byte(t.token))
+	return roundTripWithAuthorization(t.base, req, "token "+t.token, "token:"+hex.EncodeToString(sum[:4]))
+}
+
+type credentialContextKey struct{}
+
+// roundTripWithAuthorization sends req with the Authorization header set.
+// credential names the credential without revealing it, for the
+// transports below which keep per-credential state.
+func roundTripWithAuthorization(base http.RoundTripper, req *http.Request, authorization, credential string) (*http.Response, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	// RoundTrippers must not modify the original request
+	r := req.WithContext(context.WithValue(req.Context(), credentialContextKey{}, credential))
+	r.Header = make(http.Header, len(req.Header)+1)
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	r.Header.Set("Authorization", authorization)
+	return base.RoundTrip(r)
+}
+
+func requestCredential(req *http.Request) string {
+	credential, _ := req.Context().Value(credentialContextKey{}).(string)
+	return credential
+}
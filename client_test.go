@@ -0,0 +1,178 @@
+package ghbot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+func TestClientFromContext(t *testing.T) {
+	if client := ClientFromContext(context.Background()); client != nil {
+		t.Errorf("client of an empty context = %v", client)
+	}
+	client := github.NewClient(nil)
+	if got := ClientFromContext(WithClient(context.Background(), client)); got != client {
+		t.Errorf("ClientFromContext returned %v, want the client put in", got)
+	}
+}
+
+func TestSetClientFactory(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"full_name":"octocat/hello-world"}`))
+	}))
+	defer srv.Close()
+
+	var installations []int64
+	bot := New(Config{WebHookSecret: "secret"})
+	bot.SetClientFactory(func(_ context.Context, installationID int64) (*github.Client, error) {
+		installations = append(installations, installationID)
+		client := github.NewClient(nil)
+		client.BaseURL, _ = url.Parse(srv.URL + "/")
+		return client, nil
+	})
+	var raw, typed, anyEvent *github.Client
+	bot.AddRawEventHook("push", func(ctx context.Context, _ json.RawMessage) error {
+		raw = ClientFromContext(ctx)
+		return nil
+	})
+	bot.AddPushEventHook(func(ctx context.Context, e *github.PushEvent) error {
+		typed = ClientFromContext(ctx)
+		_, _, err := typed.Repositories.Get(ctx, "octocat", "hello-world")
+		return err
+	})
+	bot.AddAnyEventHook(func(ctx context.Context, _ string, _ interface{}) error {
+		anyEvent = ClientFromContext(ctx)
+		return nil
+	})
+
+	if status := deliver(bot, "secret", "push", `{"installation":{"id":7}}`); status != http.StatusOK {
+		t.Fatalf("status = %d", status)
+	}
+	// the client is created once per delivery, for its installation
+	if len(installations) != 1 || installations[0] != 7 {
+		t.Errorf("factory called for installations %v, want [7]", installations)
+	}
+	if typed == nil || raw != typed || anyEvent != typed {
+		t.Errorf("hooks got clients %p, %p and %p, want the same", raw, typed, anyEvent)
+	}
+	if len(paths) != 1 || paths[0] != "/repos/octocat/hello-world" {
+		t.Errorf("requested %v", paths)
+	}
+
+	// a client already in the context is kept
+	installations = nil
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+	if err := bot.handleWebHookPayload(WithClient(context.Background(), client), "push", []byte(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+	if len(installations) != 0 || typed != client {
+		t.Errorf("factory called for %v, hooks got %p instead of %p", installations, typed, client)
+	}
+
+	// failing to create the client fails the delivery before any hook
+	errFactory := xerrors.New("no token")
+	bot.SetClientFactory(func(context.Context, int64) (*github.Client, error) { return nil, errFactory })
+	raw, typed = nil, nil
+	if status := deliver(bot, "secret", "push", `{}`); status != http.StatusInternalServerError {
+		t.Errorf("status = %d with a failing factory", status)
+	}
+	if raw != nil || typed != nil {
+		t.Error("hooks ran without a client")
+	}
+	if err := bot.handleWebHookPayload(context.Background(), "push", []byte(`{}`)); !xerrors.Is(err, errFactory) {
+		t.Errorf("error = %v, want the error of the factory", err)
+	}
+}
+
+func TestClientWithoutCredentials(t *testing.T) {
+	bot := New(Config{WebHookSecret: "secret"})
+	ran := false
+	bot.AddPushEventHook(func(ctx context.Context, _ *github.PushEvent) error {
+		ran = true
+		if client := ClientFromContext(ctx); client != nil {
+			t.Errorf("bot without credentials put %v into the context", client)
+		}
+		return nil
+	})
+	if status := deliver(bot, "secret", "push", `{}`); status != http.StatusOK || !ran {
+		t.Errorf("status = %d, hook ran: %v", status, ran)
+	}
+}
+
+func TestTokenClient(t *testing.T) {
+	var authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	bot := New(Config{WebHookSecret: "secret", GitHubToken: "s3cr3t", EnterpriseBaseURL: srv.URL + "/api/v3/"})
+	bot.AddPushEventHook(func(ctx context.Context, _ *github.PushEvent) error {
+		_, _, err := ClientFromContext(ctx).Repositories.Get(ctx, "octocat", "hello-world")
+		return err
+	})
+	if status := deliver(bot, "secret", "push", `{}`); status != http.StatusOK {
+		t.Fatalf("status = %d", status)
+	}
+	if authorization != "token s3cr3t" {
+		t.Errorf("Authorization = %q", authorization)
+	}
+}
+
+func TestEnterpriseURLs(t *testing.T) {
+	tests := []struct {
+		baseURL, uploadURL string
+		wantBase           string
+		wantUpload         string
+	}{
+		{"https://ghe.example.com/api/v3/", "", "https://ghe.example.com/api/v3/", "https://ghe.example.com/api/uploads/"},
+		{"https://ghe.example.com/api/v3", "", "https://ghe.example.com/api/v3/", "https://ghe.example.com/api/uploads/"},
+		// a base URL without /api/v3 is used as is for uploads too
+		{"https://api.ghe.example.com", "", "https://api.ghe.example.com/", "https://api.ghe.example.com/"},
+		{"https://ghe.example.com/api/v3/", "https://uploads.ghe.example.com", "https://ghe.example.com/api/v3/", "https://uploads.ghe.example.com/"},
+	}
+	for _, tt := range tests {
+		bot := New(Config{WebHookSecret: "secret", GitHubToken: "s3cr3t", EnterpriseBaseURL: tt.baseURL, EnterpriseUploadURL: tt.uploadURL})
+		if err := bot.Validate(); err != nil {
+			t.Errorf("%s, %s: %v", tt.baseURL, tt.uploadURL, err)
+			continue
+		}
+		if got := bot.baseURL.String(); got != tt.wantBase {
+			t.Errorf("%s, %s: base URL = %s, want %s", tt.baseURL, tt.uploadURL, got, tt.wantBase)
+		}
+		if got := bot.uploadURL.String(); got != tt.wantUpload {
+			t.Errorf("%s, %s: upload URL = %s, want %s", tt.baseURL, tt.uploadURL, got, tt.wantUpload)
+		}
+
+		// every client the bot creates uses them
+		client, err := bot.clientFactory(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if client.BaseURL.String() != tt.wantBase || client.UploadURL.String() != tt.wantUpload {
+			t.Errorf("%s, %s: client uses %s and %s", tt.baseURL, tt.uploadURL, client.BaseURL, client.UploadURL)
+		}
+	}
+
+	for _, cfg := range []Config{
+		{EnterpriseUploadURL: "https://ghe.example.com/api/uploads/"},
+		{EnterpriseBaseURL: "://ghe.example.com"},
+	} {
+		if err := New(cfg).Validate(); err == nil {
+			t.Errorf("%+v is valid", cfg)
+		}
+	}
+	if bot := New(Config{}); bot.baseURL != nil || bot.uploadURL != nil {
+		t.Errorf("bot for github.com uses %s and %s", bot.baseURL, bot.uploadURL)
+	}
+}
@@ -0,0 +1,292 @@
+// Command ghbot helps developing bots locally: it sends signed webhook
+// deliveries to a running bot, verifies the signatures of captured
+// deliveries and prints template payloads.
+//
+//	ghbot send --event pull_request --file payload.json --secret s --url http://localhost:8080/webhook/github
+//	ghbot send --fixture pull_request.opened --secret s
+//	ghbot verify --secret s --file request.txt
+//	ghbot fixtures [name...]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"github.com/nasa9084/ghbot"
+	"github.com/nasa9084/ghbot/fixtures"
+	"golang.org/x/xerrors"
+)
+
+const usage = `usage: ghbot <command> [flags]
+
+commands:
+  send      send a signed webhook delivery to a bot
+  verify    check the signatures of captured deliveries
+  fixtures  list template payloads, or print the named ones
+`
+
+// stdout and stderr are where the commands write, replaced in tests.
+var (
+	stdout io.Writer = os.Stdout
+	stderr io.Writer = os.Stderr
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "send":
+		err = send(os.Args[2:])
+	case "verify":
+		err = verify(os.Args[2:])
+	case "fixtures":
+		err = printFixtures(os.Args[2:])
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ghbot %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+// headers collects repeated -H flags.
+type headers []string
+
+func (h *headers) String() string     { return strings.Join(*h, ", ") }
+func (h *headers) Set(v string) error { *h = append(*h, v); return nil }
+
+func send(args []string) error {
+	fs := flag.NewFlagSet("send", flag.ExitOnError)
+	var (
+		event      = fs.String("event", "", "event type, sent as X-GitHub-Event")
+		file       = fs.String("file", "", `file with the JSON payload, "-" for stdin`)
+		fixture    = fs.String("fixture", "", "name of a fixture to send instead of a file, see ghbot fixtures")
+		secret     = fs.String("secret", os.Getenv("GHBOT_WEBHOOK_SECRET"), "webhook secret to sign with (default $GHBOT_WEBHOOK_SECRET)")
+		target     = fs.String("url", "http://localhost:8080/webhook/github", "webhook endpoint of the bot")
+		deliveryID = fs.String("delivery", "", "delivery ID, sent as X-GitHub-Delivery (default random)")
+		form       = fs.Bool("form", false, "send the payload form encoded instead of as JSON")
+		extra      headers
+	)
+	fs.Var(&extra, "H", `additional header, written as "Name: value"; may be repeated`)
+	fs.Parse(args)
+
+	var payload []byte
+	switch {
+	case *fixture != "":
+		f, err := fixtures.Load(*fixture)
+		if err != nil {
+			return err
+		}
+		payload = f.Payload
+		if *event == "" {
+			*event = f.Event
+		}
+	case *file == "-":
+		b, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return xerrors.Errorf("error reading payload: %w", err)
+		}
+		payload = b
+	case *file != "":
+		b, err := ioutil.ReadFile(*file)
+		if err != nil {
+			return xerrors.Errorf("error reading payload: %w", err)
+		}
+		payload = b
+	default:
+		return xerrors.New("--file or --fixture is required")
+	}
+	if *event == "" {
+		return xerrors.New("--event is required")
+	}
+	if !json.Valid(payload) {
+		return xerrors.New("payload is not valid JSON")
+	}
+	if *deliveryID == "" {
+		*deliveryID = ghbot.NewDeliveryID()
+	}
+	if *secret == "" {
+		fmt.Fprintln(stderr, "warning: neither --secret nor $GHBOT_WEBHOOK_SECRET is set, sending the delivery unsigned")
+	}
+
+	d := ghbot.RecordedDelivery{
+		ID:    *deliveryID,
+		Event: *event,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+			"User-Agent":   {"GitHub-Hookshot/ghbot"},
+		},
+		Body:       string(payload),
+		ReceivedAt: time.Now(),
+	}
+	if *form {
+		d.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		d.Body = url.Values{"payload": {string(payload)}}.Encode()
+	}
+	for _, h := range extra {
+		i := strings.Index(h, ":")
+		if i < 0 {
+			return xerrors.Errorf("header must be written as \"Name: value\": %s", h)
+		}
+		d.Header.Add(strings.TrimSpace(h[:i]), strings.TrimSpace(h[i+1:]))
+	}
+	req, err := d.Request(*target, *secret)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return xerrors.Errorf("error sending delivery: %w", err)
+	}
+	defer resp.Body.Close()
+	fmt.Fprintf(stdout, "%s %s: %s\n", *event, *deliveryID, resp.Status)
+	io.Copy(stdout, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return xerrors.Errorf("delivery was answered with %s", resp.Status)
+	}
+	return nil
+}
+
+func verify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	var (
+		secret = fs.String("secret", os.Getenv("GHBOT_WEBHOOK_SECRET"), "webhook secret (default $GHBOT_WEBHOOK_SECRET)")
+		file   = fs.String("file", "-", `captured HTTP request, or recording of deliveries in JSONL; "-" for stdin`)
+	)
+	fs.Parse(args)
+
+	in := os.Stdin
+	if *file != "-" {
+		f, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	ds, err := readCaptured(bufio.NewReader(in))
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, d := range ds {
+		if err := verifyDelivery(d, []byte(*secret)); err != nil {
+			failed++
+			fmt.Fprintf(stdout, "%s %s: %v\n", d.Event, d.ID, err)
+			continue
+		}
+		fmt.Fprintf(stdout, "%s %s: ok\n", d.Event, d.ID)
+	}
+	if failed > 0 {
+		return xerrors.Errorf("%d of %d deliveries have invalid signatures", failed, len(ds))
+	}
+	return nil
+}
+
+// readCaptured reads either a raw HTTP request or JSONL as written by
+// ghbot.Recorder.
+func readCaptured(r *bufio.Reader) ([]*ghbot.RecordedDelivery, error) {
+	head, err := r.Peek(1)
+	if err != nil {
+		return nil, xerrors.Errorf("error reading captured request: %w", err)
+	}
+	if head[0] != '{' {
+		req, err := http.ReadRequest(r)
+		if err != nil {
+			return nil, xerrors.Errorf("error parsing captured request: %w", err)
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, xerrors.Errorf("error reading captured request: %w", err)
+		}
+		return []*ghbot.RecordedDelivery{{
+			ID:           req.Header.Get("X-GitHub-Delivery"),
+			Event:        req.Header.Get("X-GitHub-Event"),
+			Signature:    req.Header.Get("X-Hub-Signature"),
+			Signature256: req.Header.Get("X-Hub-Signature-256"),
+			Header:       req.Header,
+			Body:         string(body),
+		}}, nil
+	}
+	var ds []*ghbot.RecordedDelivery
+	dec := json.NewDecoder(r)
+	for {
+		var d ghbot.RecordedDelivery
+		if err := dec.Decode(&d); err == io.EOF {
+			return ds, nil
+		} else if err != nil {
+			return nil, xerrors.Errorf("error parsing recording: %w", err)
+		}
+		ds = append(ds, &d)
+	}
+}
+
+// verifyDelivery checks every signature the delivery carries against the
+// body exactly as captured, as the bot does.
+func verifyDelivery(d *ghbot.RecordedDelivery, secret []byte) error {
+	if d.Signature == "" && d.Signature256 == "" {
+		return xerrors.New("not signed")
+	}
+	for _, sig := range []string{d.Signature, d.Signature256} {
+		if sig == "" {
+			continue
+		}
+		if err := github.ValidateSignature(sig, []byte(d.Body), secret); err != nil {
+			return xerrors.Errorf("%s: %w", sig[:strings.Index(sig+"=", "=")], err)
+		}
+	}
+	return nil
+}
+
+func printFixtures(args []string) error {
+	fs := flag.NewFlagSet("fixtures", flag.ExitOnError)
+	event := fs.String("event", "", "only list fixtures of the event type")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), "usage: ghbot fixtures [--event type] [name...]\n\nWithout names, the fixtures are listed. With names, their payloads are printed.\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		all := fixtures.All()
+		if *event != "" {
+			all = fixtures.ForEvent(*event)
+		}
+		for _, f := range all {
+			fmt.Fprintln(stdout, f.Name)
+		}
+		return nil
+	}
+	for _, name := range fs.Args() {
+		f, err := fixtures.Load(name)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, f.Payload, "", "  "); err != nil {
+			return xerrors.Errorf("error formatting %s: %w", name, err)
+		}
+		buf.WriteByte('\n')
+		buf.WriteTo(stdout)
+	}
+	return nil
+}
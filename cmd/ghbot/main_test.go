@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nasa9084/ghbot"
+)
+
+// capture redirects stdout and stderr of the commands for the test.
+func capture() (out, errOut *bytes.Buffer, restore func()) {
+	out, errOut = &bytes.Buffer{}, &bytes.Buffer{}
+	stdout, stderr = out, errOut
+	return out, errOut, func() { stdout, stderr = os.Stdout, os.Stderr }
+}
+
+func TestSend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ghbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	payload := filepath.Join(dir, "push.json")
+	if err := ioutil.WriteFile(payload, []byte(`{"ref":"refs/heads/master"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	bot := ghbot.New(ghbot.Config{WebHookSecret: "secret"})
+	var received []string
+	bot.AddRawEventHook("push", func(ctx context.Context, p json.RawMessage) error {
+		md := ghbot.DeliveryFromContext(ctx)
+		received = append(received, md.ID+" "+string(p)+" "+md.HookID)
+		return nil
+	})
+	srv := httptest.NewServer(bot.Handler())
+	defer srv.Close()
+
+	out, errOut, restore := capture()
+	defer restore()
+
+	tests := []struct {
+		args    []string
+		wantErr string
+		want    string
+	}{
+		{
+			args: []string{"--event", "push", "--file", payload, "--delivery", "1", "-H", "X-GitHub-Hook-ID: 42"},
+			want: `1 {"ref":"refs/heads/master"} 42`,
+		},
+		{
+			args: []string{"--event", "push", "--file", payload, "--delivery", "2", "--form"},
+			want: `2 {"ref":"refs/heads/master"} `,
+		},
+		{
+			args:    []string{"--event", "push", "--file", payload, "--secret", "other"},
+			wantErr: "delivery was answered with 400 Bad Request",
+		},
+		{args: []string{"--file", payload}, wantErr: "--event is required"},
+		{args: []string{"--event", "push"}, wantErr: "--file or --fixture is required"},
+		{args: []string{"--event", "push", "--file", payload, "-H", "X-Broken"}, wantErr: `header must be written as "Name: value"`},
+	}
+	for _, tt := range tests {
+		received = nil
+		args := append([]string{"--url", srv.URL, "--secret", "secret"}, tt.args...)
+		err := send(args)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%v: error = %v, want %s", tt.args, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %v", tt.args, err)
+			continue
+		}
+		if len(received) != 1 || received[0] != tt.want {
+			t.Errorf("%v: bot received %q, want %q", tt.args, received, tt.want)
+		}
+	}
+	if !strings.Contains(out.String(), "push 1: 200 OK") {
+		t.Errorf("output = %q", out.String())
+	}
+	if errOut.Len() != 0 {
+		t.Errorf("warned %q with a secret", errOut.String())
+	}
+
+	// sending without a secret warns
+	err = send([]string{"--url", srv.URL, "--secret", "", "--event", "push", "--file", payload})
+	if err == nil {
+		t.Error("unsigned delivery was accepted by a bot with a secret")
+	}
+	if !strings.Contains(errOut.String(), "sending the delivery unsigned") {
+		t.Errorf("warnings = %q", errOut.String())
+	}
+}
+
+// capturedRequest returns a raw HTTP request of a delivery of body signed
+// with secret.
+func capturedRequest(secret, body string) string {
+	signature, signature256 := ghbot.SignPayload(secret, []byte(body))
+	return fmt.Sprintf("POST /webhook/github HTTP/1.1\r\n"+
+		"Host: localhost\r\n"+
+		"Content-Type: application/json\r\n"+
+		"Content-Length: %d\r\n"+
+		"X-GitHub-Event: push\r\n"+
+		"X-GitHub-Delivery: 1\r\n"+
+		"X-Hub-Signature: %s\r\n"+
+		"X-Hub-Signature-256: %s\r\n"+
+		"\r\n%s", len(body), signature, signature256, body)
+}
+
+func TestVerify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ghbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	recording := func(ds ...*ghbot.RecordedDelivery) string {
+		var buf bytes.Buffer
+		for _, d := range ds {
+			json.NewEncoder(&buf).Encode(d)
+		}
+		return buf.String()
+	}
+	delivery := func(id, secret, body string) *ghbot.RecordedDelivery {
+		signature, signature256 := ghbot.SignPayload(secret, []byte(body))
+		return &ghbot.RecordedDelivery{ID: id, Event: "push", Signature: signature, Signature256: signature256, Body: body}
+	}
+	// signed over the body without the newline it gained
+	trailing := delivery("3", "secret", `{}`)
+	trailing.Body += "\n"
+	sha1Only := delivery("4", "secret", `{}`)
+	sha1Only.Signature256 = ""
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+		want    []string
+	}{
+		{name: "request", input: capturedRequest("secret", `{"zen":"ok"}`), want: []string{"push 1: ok"}},
+		{name: "request signed with another secret", input: capturedRequest("other", `{}`), wantErr: "1 of 1 deliveries", want: []string{"push 1: sha1:"}},
+		{name: "recording", input: recording(delivery("1", "secret", `{}`), sha1Only), want: []string{"push 1: ok", "push 4: ok"}},
+		{
+			name:    "recording with a mismatch",
+			input:   recording(delivery("1", "secret", `{}`), delivery("2", "other", `{}`), trailing),
+			wantErr: "2 of 3 deliveries",
+			want:    []string{"push 1: ok", "push 2: sha1:", "push 3: sha1:"},
+		},
+		{name: "unsigned", input: recording(&ghbot.RecordedDelivery{ID: "1", Event: "push", Body: `{}`}), wantErr: "1 of 1 deliveries", want: []string{"push 1: not signed"}},
+		{name: "malformed recording", input: "{\n", wantErr: "error parsing recording"},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(dir, "captured")
+		if err := ioutil.WriteFile(path, []byte(tt.input), 0600); err != nil {
+			t.Fatal(err)
+		}
+		out, _, restore := capture()
+		err := verify([]string{"--secret", "secret", "--file", path})
+		restore()
+		if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+			t.Errorf("%s: error = %v, want %q", tt.name, err, tt.wantErr)
+		}
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		if len(tt.want) == 0 {
+			continue
+		}
+		if len(lines) != len(tt.want) {
+			t.Errorf("%s: printed %q", tt.name, lines)
+			continue
+		}
+		for i, want := range tt.want {
+			if !strings.HasPrefix(lines[i], want) {
+				t.Errorf("%s: line %d = %q, want %q...", tt.name, i, lines[i], want)
+			}
+		}
+	}
+}
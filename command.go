@@ -0,0 +1,608 @@
+package ghbot
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+// CommandSource tells which kind of GitHub object a command was found in.
+type CommandSource int
+
+const (
+	IssueCommentSource CommandSource = iota
+	PullRequestReviewCommentSource
+	PullRequestReviewSource
+)
+
+func (src CommandSource) String() string {
+	switch src {
+	case IssueCommentSource:
+		return "issue_comment"
+	case PullRequestReviewCommentSource:
+		return "pull_request_review_comment"
+	case PullRequestReviewSource:
+		return "pull_request_review"
+	}
+	return "unknown"
+}
+
+// CommandInvocation is a single command found in a comment.
+type CommandInvocation struct {
+	Name string
+	Args []string
+	// RawArgs is the rest of the command line as written.
+	RawArgs string
+
+	Source CommandSource
+	// Action is the action of the event, e.g. "created" or "edited".
+	Action string
+
+	Owner         string
+	Repo          string
+	Number        int
+	IsPullRequest bool
+
+	// Issue is set for commands in issue comments, including comments on
+	// pull requests. PullRequest is set for commands in pull request
+	// reviews and review comments.
+	Issue       *github.Issue
+	PullRequest *github.PullRequest
+
+	// CommentID is the ID of the issue comment, review comment or review
+	// the command was found in. Body is its whole body.
+	CommentID int64
+	Body      string
+
+	// Commenter is the user whose action triggered the command: the
+	// author of a new comment, or the editor of an edited one.
+	Commenter         *github.User
+	AuthorAssociation string
+
+	Repository *github.Repository
+	Event      interface{}
+}
+
+// CommandHandler handles a command invocation.
+type CommandHandler func(context.Context, *CommandInvocation) error
+
+// Command describes a slash command.
+type Command struct {
+	// Name is the command name without the prefix, e.g. "retest".
+	// Names are matched case-insensitively.
+	Name    string
+	Handler CommandHandler
+	// Require restricts who may run the command.
+	Require CommandRequirement
+	// Repos limits the command to the given "owner/repo" repositories.
+	// The command is available everywhere when empty.
+	Repos []string
+
+	// Usage, Description and Examples are shown by /help. Usage defaults
+	// to the prefixed name.
+	Usage       string
+	Description string
+	Examples    []string
+}
+
+func (cmd Command) availableIn(owner, repo string) bool {
+	if len(cmd.Repos) == 0 {
+		return true
+	}
+	for _, r := range cmd.Repos {
+		if strings.EqualFold(r, owner+"/"+repo) {
+			return true
+		}
+	}
+	return false
+}
+
+// CommandRouterConfig configures a CommandRouter.
+type CommandRouterConfig struct {
+	// Prefix starts a command. The default is "/".
+	Prefix string
+	// AcceptEdited makes the router run commands found in edited
+	// comments and reviews. By default only new ones are processed.
+	AcceptEdited bool
+	// AcceptDeleted makes the router run commands found in deleted
+	// comments and dismissed reviews.
+	AcceptDeleted bool
+
+	// Client is used to check command requirements and to reply to
+	// denied, unknown and help commands. When nil, the client the bot
+	// put into the hook's context is used.
+	Client *github.Client
+	// PermissionCacheTTL is how long permission and membership lookups
+	// are cached. The default is 5 minutes.
+	PermissionCacheTTL time.Duration
+	// DeniedReply is a text/template of a reply posted when a command is
+	// denied. It is executed with the *CommandInvocation, e.g.
+	// "@{{.Commenter.Login}} you cannot run /{{.Name}}".
+	DeniedReply string
+	// DeniedReaction is a reaction added to the comment of a denied
+	// command, e.g. "-1" or "confused".
+	DeniedReaction string
+
+	// DisableHelp turns off the built-in /help command. A command named
+	// "help" added to the router replaces the built-in one.
+	DisableHelp bool
+	// DisableSuggestions turns off replying with the closest command
+	// when an unknown command is used.
+	DisableSuggestions bool
+
+	// AckReactions makes the router react to the comment of an accepted
+	// command with "eyes" on receipt, then "+1" on success or "confused"
+	// on failure. Reviews cannot have reactions and are not acknowledged.
+	AckReactions bool
+}
+
+// CommandLifecycleHook is notified as an accepted command is processed.
+// Any of the functions may be nil.
+type CommandLifecycleHook struct {
+	// Received is called before the handler runs. An error aborts the
+	// command.
+	Received func(context.Context, *CommandInvocation) error
+	// Succeeded is called after the handler returned successfully.
+	Succeeded func(context.Context, *CommandInvocation) error
+	// Failed is called with the error of the handler.
+	Failed func(context.Context, *CommandInvocation, error) error
+}
+
+// CommandRouter finds slash commands in issue comments, pull request
+// review comments and review bodies and dispatches them to handlers.
+//
+// A command is a line of the body which starts with the prefix, e.g.
+// "/label bug", followed by its arguments. Arguments are separated by
+// spaces and can be quoted with single or double quotes. Lines in fenced
+// or indented code blocks and quoted replies are skipped.
+type CommandRouter struct {
+	mu             sync.Mutex
+	cfg            CommandRouterConfig
+	commands       map[string]Command
+	lifecycleHooks []CommandLifecycleHook
+	permissions    *permissionCache
+	logger         func() Logger
+}
+
+func NewCommandRouter(cfg CommandRouterConfig) *CommandRouter {
+	if cfg.Prefix == "" {
+		cfg.Prefix = "/"
+	}
+	if cfg.PermissionCacheTTL <= 0 {
+		cfg.PermissionCacheTTL = 5 * time.Minute
+	}
+	router := CommandRouter{
+		cfg:         cfg,
+		commands:    map[string]Command{},
+		permissions: newPermissionCache(cfg.PermissionCacheTTL),
+		logger:      func() Logger { return nopLogger{} },
+	}
+	if cfg.AckReactions {
+		router.lifecycleHooks = append(router.lifecycleHooks, router.ackReactionHook())
+	}
+	return &router
+}
+
+func (r *CommandRouter) AddCommand(cmd Command) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.commands[strings.ToLower(cmd.Name)] = cmd
+}
+
+// client returns the client the router talks to GitHub with, the
+// configured one or the one of the delivery.
+func (r *CommandRouter) client(ctx context.Context) *github.Client {
+	if r.cfg.Client != nil {
+		return r.cfg.Client
+	}
+	return ClientFromContext(ctx)
+}
+
+func (r *CommandRouter) AddLifecycleHook(hook CommandLifecycleHook) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lifecycleHooks = append(r.lifecycleHooks, hook)
+}
+
+// log returns the logger of the bot the router was added to.
+func (r *CommandRouter) log() Logger {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.logger()
+}
+
+// command returns the command of the name which is available in the
+// repository, including the built-in help command.
+func (r *CommandRouter) command(name, owner, repo string) (Command, bool) {
+	r.mu.Lock()
+	cmd, ok := r.commands[name]
+	r.mu.Unlock()
+
+	if ok && cmd.availableIn(owner, repo) {
+		return cmd, true
+	}
+	if name == helpCommandName && !r.cfg.DisableHelp {
+		return r.helpCommand(), true
+	}
+	return Command{}, false
+}
+
+// availableCommands returns the commands available in the repository
+// sorted by name.
+func (r *CommandRouter) availableCommands(owner, repo string) []Command {
+	r.mu.Lock()
+	var cmds []Command
+	hasHelp := false
+	for name, cmd := range r.commands {
+		if cmd.availableIn(owner, repo) {
+			cmds = append(cmds, cmd)
+			hasHelp = hasHelp || name == helpCommandName
+		}
+	}
+	r.mu.Unlock()
+
+	if !hasHelp && !r.cfg.DisableHelp {
+		cmds = append(cmds, r.helpCommand())
+	}
+	sort.Slice(cmds, func(i, j int) bool {
+		return strings.ToLower(cmds[i].Name) < strings.ToLower(cmds[j].Name)
+	})
+	return cmds
+}
+
+// AddCommandRouter registers the hooks which feed the router. Removing
+// the returned handle detaches the router from the bot.
+func (bot *Bot) AddCommandRouter(router *CommandRouter) *HookHandle {
+	router.mu.Lock()
+	router.logger = func() Logger { return bot.logger }
+	router.mu.Unlock()
+	return &HookHandle{
+		group: []*HookHandle{
+			bot.AddIssueCommentEventHook(router.handleIssueCommentEvent),
+			bot.AddPullRequestReviewCommentEventHook(router.handlePullRequestReviewCommentEvent),
+			bot.AddPullRequestReviewEventHook(router.handlePullRequestReviewEvent),
+		},
+	}
+}
+
+func (r *CommandRouter) acceptAction(action string) bool {
+	switch action {
+	case "created", "submitted":
+		return true
+	case "edited":
+		return r.cfg.AcceptEdited
+	case "deleted", "dismissed":
+		return r.cfg.AcceptDeleted
+	}
+	return false
+}
+
+func (r *CommandRouter) handleIssueCommentEvent(ctx context.Context, e *github.IssueCommentEvent) error {
+	if !r.acceptAction(e.GetAction()) {
+		return nil
+	}
+	base := CommandInvocation{
+		Source:            IssueCommentSource,
+		Action:            e.GetAction(),
+		Owner:             e.GetRepo().GetOwner().GetLogin(),
+		Repo:              e.GetRepo().GetName(),
+		Number:            e.GetIssue().GetNumber(),
+		IsPullRequest:     e.GetIssue().IsPullRequest(),
+		Issue:             e.GetIssue(),
+		CommentID:         e.GetComment().GetID(),
+		Body:              e.GetComment().GetBody(),
+		Commenter:         e.GetSender(),
+		AuthorAssociation: e.GetComment().GetAuthorAssociation(),
+		Repository:        e.GetRepo(),
+		Event:             e,
+	}
+	return r.dispatch(ctx, base)
+}
+
+func (r *CommandRouter) handlePullRequestReviewCommentEvent(ctx context.Context, e *github.PullRequestReviewCommentEvent) error {
+	if !r.acceptAction(e.GetAction()) {
+		return nil
+	}
+	base := CommandInvocation{
+		Source:            PullRequestReviewCommentSource,
+		Action:            e.GetAction(),
+		Owner:             e.GetRepo().GetOwner().GetLogin(),
+		Repo:              e.GetRepo().GetName(),
+		Number:            e.GetPullRequest().GetNumber(),
+		IsPullRequest:     true,
+		PullRequest:       e.GetPullRequest(),
+		CommentID:         e.GetComment().GetID(),
+		Body:              e.GetComment().GetBody(),
+		Commenter:         e.GetSender(),
+		AuthorAssociation: e.GetComment().GetAuthorAssociation(),
+		Repository:        e.GetRepo(),
+		Event:             e,
+	}
+	return r.dispatch(ctx, base)
+}
+
+func (r *CommandRouter) handlePullRequestReviewEvent(ctx context.Context, e *github.PullRequestReviewEvent) error {
+	if !r.acceptAction(e.GetAction()) {
+		return nil
+	}
+	base := CommandInvocation{
+		Source:        PullRequestReviewSource,
+		Action:        e.GetAction(),
+		Owner:         e.GetRepo().GetOwner().GetLogin(),
+		Repo:          e.GetRepo().GetName(),
+		Number:        e.GetPullRequest().GetNumber(),
+		IsPullRequest: true,
+		PullRequest:   e.GetPullRequest(),
+		CommentID:     e.GetReview().GetID(),
+		Body:          e.GetReview().GetBody(),
+		Commenter:     e.GetSender(),
+		Repository:    e.GetRepo(),
+		Event:         e,
+	}
+	return r.dispatch(ctx, base)
+}
+
+// dispatch processes the commands of a comment in the order they appear.
+// A command which fails does not stop the ones after it; the errors of
+// all failed commands are returned together.
+func (r *CommandRouter) dispatch(ctx context.Context, base CommandInvocation) error {
+	var errs CommandErrors
+	for _, line := range parseCommandLines(base.Body, r.cfg.Prefix) {
+		inv := base
+		inv.Name = line.name
+		inv.Args = line.args
+		inv.RawArgs = line.rawArgs
+		if err := r.dispatchOne(ctx, &inv); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (r *CommandRouter) dispatchOne(ctx context.Context, inv *CommandInvocation) error {
+	cmd, ok := r.command(inv.Name, inv.Owner, inv.Repo)
+	if !ok {
+		if err := r.suggest(ctx, inv); err != nil {
+			return xerrors.Errorf("error suggesting command for %s: %w", inv.Name, err)
+		}
+		return nil
+	}
+	ok, err := r.allowed(ctx, cmd.Require, inv)
+	if err != nil {
+		return xerrors.Errorf("error checking requirement of command %s: %w", inv.Name, err)
+	}
+	if !ok {
+		if err := r.deny(ctx, inv); err != nil {
+			return xerrors.Errorf("error denying command %s: %w", inv.Name, err)
+		}
+		return nil
+	}
+	if err := r.run(ctx, cmd, inv); err != nil {
+		return xerrors.Errorf("error on command %s: %w", inv.Name, err)
+	}
+	return nil
+}
+
+// CommandErrors holds the errors of the commands of a single comment
+// which failed.
+type CommandErrors []error
+
+func (errs CommandErrors) Error() string {
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Is reports whether any of the errors matches target.
+func (errs CommandErrors) Is(target error) bool {
+	for _, err := range errs {
+		if xerrors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the errors which xerrors.As can assign to target.
+func (errs CommandErrors) As(target interface{}) bool {
+	for _, err := range errs {
+		if xerrors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// run runs the handler of an accepted command, notifying the lifecycle
+// hooks around it.
+func (r *CommandRouter) run(ctx context.Context, cmd Command, inv *CommandInvocation) error {
+	r.mu.Lock()
+	hooks := r.lifecycleHooks
+	r.mu.Unlock()
+
+	for _, hook := range hooks {
+		if hook.Received == nil {
+			continue
+		}
+		if err := hook.Received(ctx, inv); err != nil {
+			return err
+		}
+	}
+	if err := cmd.Handler(ctx, inv); err != nil {
+		for _, hook := range hooks {
+			if hook.Failed == nil {
+				continue
+			}
+			if herr := hook.Failed(ctx, inv, err); herr != nil {
+				return xerrors.Errorf("error on failure hook: %v: %w", herr, err)
+			}
+		}
+		return err
+	}
+	for _, hook := range hooks {
+		if hook.Succeeded == nil {
+			continue
+		}
+		if err := hook.Succeeded(ctx, inv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ackReactionHook reacts to the comments of commands. Acknowledgements
+// are best-effort: a reaction which cannot be added is logged, and neither
+// stops the command nor replaces the error of its handler.
+func (r *CommandRouter) ackReactionHook() CommandLifecycleHook {
+	reactWith := func(content string) func(context.Context, *CommandInvocation) error {
+		return func(ctx context.Context, inv *CommandInvocation) error {
+			client := r.client(ctx)
+			if client == nil {
+				r.log().Printf("cannot acknowledge command %s without a GitHub client", inv.Name)
+				return nil
+			}
+			if err := react(ctx, client, inv, content); err != nil {
+				r.log().Printf("error acknowledging command %s: %+v", inv.Name, err)
+			}
+			return nil
+		}
+	}
+	return CommandLifecycleHook{
+		Received:  reactWith("eyes"),
+		Succeeded: reactWith("+1"),
+		Failed: func(ctx context.Context, inv *CommandInvocation, _ error) error {
+			return reactWith("confused")(ctx, inv)
+		},
+	}
+}
+
+type commandLine struct {
+	name    string
+	args    []string
+	rawArgs string
+}
+
+var commandNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// parseCommandLines returns the command lines of a markdown body in the
+// order they appear.
+func parseCommandLines(body, prefix string) []commandLine {
+	var cmds []commandLine
+	var fence string
+	for _, line := range strings.Split(strings.Replace(body, "\r\n", "\n", -1), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		indent := len(line) - len(trimmed)
+		if fence != "" {
+			if indent < 4 && strings.HasPrefix(trimmed, fence) && strings.TrimSpace(strings.TrimLeft(trimmed, fence[:1])) == "" {
+				fence = ""
+			}
+			continue
+		}
+		if indent < 4 {
+			if f := codeFence(trimmed); f != "" {
+				fence = f
+				continue
+			}
+		}
+		if indent >= 4 || strings.HasPrefix(line, "\t") || strings.HasPrefix(trimmed, ">") {
+			continue
+		}
+		if !strings.HasPrefix(trimmed, prefix) {
+			continue
+		}
+		name, rawArgs := strings.TrimPrefix(trimmed, prefix), ""
+		if i := strings.IndexFunc(name, unicode.IsSpace); i >= 0 {
+			name, rawArgs = name[:i], strings.TrimSpace(name[i:])
+		}
+		if !commandNameRe.MatchString(name) {
+			continue
+		}
+		cmd := commandLine{
+			name:    strings.ToLower(name),
+			rawArgs: rawArgs,
+		}
+		if rawArgs != "" {
+			cmd.args = splitArgs(rawArgs)
+		}
+		cmds = append(cmds, cmd)
+	}
+	return cmds
+}
+
+// codeFence returns the opening fence if the line starts a fenced code
+// block.
+func codeFence(line string) string {
+	for _, c := range []string{"`", "~"} {
+		n := len(line) - len(strings.TrimLeft(line, c))
+		if n >= 3 {
+			return strings.Repeat(c, n)
+		}
+	}
+	return ""
+}
+
+// splitArgs splits command arguments on white space. Single quotes keep
+// their content as is, double quotes additionally allow backslash escapes.
+func splitArgs(s string) []string {
+	var args []string
+	var buf strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+	for _, c := range s {
+		switch {
+		case escaped:
+			buf.WriteRune(c)
+			escaped = false
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+				continue
+			}
+			buf.WriteRune(c)
+		case quote == '"':
+			switch c {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				buf.WriteRune(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inArg = true
+		case c == '\\':
+			escaped = true
+			inArg = true
+		case unicode.IsSpace(c):
+			if inArg {
+				args = append(args, buf.String())
+				buf.Reset()
+				inArg = false
+			}
+		default:
+			buf.WriteRune(c)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, buf.String())
+	}
+	return args
+}
@@ -0,0 +1,141 @@
+package ghbot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+const helpCommandName = "help"
+
+func (r *CommandRouter) helpCommand() Command {
+	return Command{
+		Name:        helpCommandName,
+		Description: "Shows the commands available in this repository.",
+		Handler:     r.handleHelp,
+	}
+}
+
+func (r *CommandRouter) handleHelp(ctx context.Context, inv *CommandInvocation) error {
+	client := r.client(ctx)
+	if client == nil {
+		return xerrors.New("help command needs a GitHub client")
+	}
+	return reply(ctx, client, inv, r.helpMessage(inv.Owner, inv.Repo))
+}
+
+// helpMessage renders the commands available in the repository as a
+// markdown table.
+func (r *CommandRouter) helpMessage(owner, repo string) string {
+	var buf strings.Builder
+	buf.WriteString("| Command | Description | Permission | Examples |\n")
+	buf.WriteString("| --- | --- | --- | --- |\n")
+	for _, cmd := range r.availableCommands(owner, repo) {
+		usage := cmd.Usage
+		if usage == "" {
+			usage = r.cfg.Prefix + strings.ToLower(cmd.Name)
+		}
+		examples := make([]string, 0, len(cmd.Examples))
+		for _, ex := range cmd.Examples {
+			examples = append(examples, markdownCode(ex))
+		}
+		fmt.Fprintf(&buf, "| %s | %s | %s | %s |\n",
+			markdownCode(usage),
+			markdownCell(cmd.Description),
+			markdownCell(cmd.Require.String()),
+			strings.Join(examples, "<br>"),
+		)
+	}
+	return buf.String()
+}
+
+// suggest answers an unknown command with the closest available one, if
+// any is close enough to be a likely typo.
+func (r *CommandRouter) suggest(ctx context.Context, inv *CommandInvocation) error {
+	client := r.client(ctx)
+	if r.cfg.DisableSuggestions || client == nil {
+		return nil
+	}
+	best, bestDist := "", -1
+	for _, cmd := range r.availableCommands(inv.Owner, inv.Repo) {
+		name := strings.ToLower(cmd.Name)
+		d := levenshtein(inv.Name, name)
+		if bestDist < 0 || d < bestDist {
+			best, bestDist = name, d
+		}
+	}
+	if bestDist < 0 || bestDist > 2 || bestDist >= len(inv.Name) {
+		return nil
+	}
+	msg := fmt.Sprintf("Unknown command %s. Did you mean %s?",
+		markdownCode(r.cfg.Prefix+inv.Name),
+		markdownCode(r.cfg.Prefix+best),
+	)
+	return reply(ctx, client, inv, msg)
+}
+
+// String describes the requirement for the help table.
+func (req CommandRequirement) String() string {
+	if req.isEmpty() {
+		return "anyone"
+	}
+	var conds []string
+	if req.Permission != PermissionNone {
+		conds = append(conds, req.Permission.String())
+	}
+	for _, org := range req.Orgs {
+		conds = append(conds, "member of @"+org)
+	}
+	for _, team := range req.Teams {
+		conds = append(conds, "member of @"+team)
+	}
+	if req.Author {
+		conds = append(conds, "author")
+	}
+	if len(req.AuthorAssociations) > 0 {
+		conds = append(conds, strings.Join(req.AuthorAssociations, ", "))
+	}
+	return strings.Join(conds, " or ")
+}
+
+func markdownCell(s string) string {
+	s = strings.Replace(s, "|", `\|`, -1)
+	return strings.Replace(s, "\n", " ", -1)
+}
+
+func markdownCode(s string) string {
+	return "`" + markdownCell(s) + "`"
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
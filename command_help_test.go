@@ -0,0 +1,149 @@
+package ghbot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"label", "label", 0},
+		{"lable", "label", 2},
+		{"labels", "label", 1},
+		{"retset", "retest", 2},
+		{"kitten", "sitting", 3},
+		{"ラベル", "ラベ", 1},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCommandRequirementString(t *testing.T) {
+	tests := []struct {
+		req  CommandRequirement
+		want string
+	}{
+		{CommandRequirement{}, "anyone"},
+		{CommandRequirement{Permission: PermissionTriage}, "triage"},
+		{CommandRequirement{Orgs: []string{"acme"}, Teams: []string{"acme/core"}}, "member of @acme or member of @acme/core"},
+		{CommandRequirement{Permission: PermissionWrite, Author: true}, "write or author"},
+		{CommandRequirement{AuthorAssociations: []string{"OWNER", "MEMBER"}}, "OWNER, MEMBER"},
+	}
+	for _, tt := range tests {
+		if got := tt.req.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.req, got, tt.want)
+		}
+	}
+}
+
+func TestHelpMessage(t *testing.T) {
+	router := NewCommandRouter(CommandRouterConfig{Prefix: "!"})
+	router.AddCommand(Command{
+		Name:        "Label",
+		Description: "Adds labels.\nRemoves them with -.",
+		Require:     CommandRequirement{Permission: PermissionTriage},
+		Examples:    []string{"!label bug", "!label -bug"},
+	})
+	router.AddCommand(Command{
+		Name:        "deploy",
+		Usage:       "!deploy <env|all>",
+		Description: "Deploys the branch.",
+		Require:     CommandRequirement{Teams: []string{"acme/ops"}, Author: true},
+	})
+	router.AddCommand(Command{Name: "assign", Repos: []string{"octocat/other"}})
+
+	want := "| Command | Description | Permission | Examples |\n" +
+		"| --- | --- | --- | --- |\n" +
+		"| `!deploy <env\\|all>` | Deploys the branch. | member of @acme/ops or author |  |\n" +
+		"| `!help` | Shows the commands available in this repository. | anyone |  |\n" +
+		"| `!label` | Adds labels. Removes them with -. | triage | `!label bug`<br>`!label -bug` |\n"
+	if got := router.helpMessage("octocat", "hello-world"); got != want {
+		t.Errorf("help message =\n%s\nwant\n%s", got, want)
+	}
+
+	// commands limited to other repositories are hidden, and the built-in
+	// help can be turned off
+	router = NewCommandRouter(CommandRouterConfig{DisableHelp: true})
+	router.AddCommand(Command{Name: "assign", Repos: []string{"octocat/other"}})
+	want = "| Command | Description | Permission | Examples |\n| --- | --- | --- | --- |\n"
+	if got := router.helpMessage("octocat", "hello-world"); got != want {
+		t.Errorf("help message =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		replies []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var comment github.IssueComment
+		json.NewDecoder(r.Body).Decode(&comment)
+		mu.Lock()
+		replies = append(replies, comment.GetBody())
+		mu.Unlock()
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	tests := []struct {
+		name    string
+		command string
+		disable bool
+		want    string
+	}{
+		{name: "transposition", command: "retset", want: "Unknown command `/retset`. Did you mean `/retest`?"},
+		{name: "one letter off", command: "labels", want: "Unknown command `/labels`. Did you mean `/label`?"},
+		{name: "closest", command: "hepl", want: "Unknown command `/hepl`. Did you mean `/help`?"},
+		{name: "distance of 3", command: "rexxxt", want: ""},
+		{name: "too far", command: "lgtm", want: ""},
+		{name: "command of another repository", command: "asign", want: ""},
+		{name: "shorter than the distance", command: "x", want: ""},
+		{name: "disabled", command: "retset", disable: true, want: ""},
+	}
+	for _, tt := range tests {
+		router := NewCommandRouter(CommandRouterConfig{Client: client, DisableSuggestions: tt.disable})
+		for _, name := range []string{"retest", "label"} {
+			router.AddCommand(Command{Name: name})
+		}
+		router.AddCommand(Command{Name: "assign", Repos: []string{"octocat/other"}})
+
+		mu.Lock()
+		replies = nil
+		mu.Unlock()
+		inv := &CommandInvocation{Name: tt.command, Owner: "octocat", Repo: "hello-world", Number: 1}
+		if err := router.suggest(context.Background(), inv); err != nil {
+			t.Fatal(err)
+		}
+		mu.Lock()
+		got := ""
+		if len(replies) > 0 {
+			got = replies[0]
+		}
+		if len(replies) > 1 {
+			t.Errorf("%s: replied %q", tt.name, replies)
+		}
+		mu.Unlock()
+		if got != tt.want {
+			t.Errorf("%s: replied %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,339 @@
+package ghbot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+// PermissionLevel is a collaborator permission level of a repository.
+type PermissionLevel int
+
+const (
+	PermissionNone PermissionLevel = iota
+	PermissionRead
+	PermissionTriage
+	PermissionWrite
+	PermissionMaintain
+	PermissionAdmin
+)
+
+var permissionLevelNames = map[PermissionLevel]string{
+	PermissionNone:     "none",
+	PermissionRead:     "read",
+	PermissionTriage:   "triage",
+	PermissionWrite:    "write",
+	PermissionMaintain: "maintain",
+	PermissionAdmin:    "admin",
+}
+
+func (lv PermissionLevel) String() string {
+	if name, ok := permissionLevelNames[lv]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParsePermissionLevel parses a permission level as returned by the GitHub
+// API. "pull" and "push" are accepted as aliases of read and write.
+func ParsePermissionLevel(s string) (PermissionLevel, error) {
+	switch strings.ToLower(s) {
+	case "pull":
+		return PermissionRead, nil
+	case "push":
+		return PermissionWrite, nil
+	}
+	for lv, name := range permissionLevelNames {
+		if strings.EqualFold(s, name) {
+			return lv, nil
+		}
+	}
+	return PermissionNone, xerrors.Errorf("unknown permission level: %s", s)
+}
+
+// CommandRequirement restricts who may run a command. A command with an
+// empty requirement can be run by anyone. Otherwise the commenter must
+// satisfy at least one of the given conditions.
+type CommandRequirement struct {
+	// Permission is the minimum collaborator permission on the repository.
+	Permission PermissionLevel
+	// Orgs are organizations the commenter may be a member of.
+	Orgs []string
+	// Teams are teams the commenter may be a member of, written as
+	// "org/team-slug".
+	Teams []string
+	// Author allows the author of the issue or pull request.
+	Author bool
+	// AuthorAssociations are values of author_association in the payload,
+	// e.g. "OWNER", "MEMBER" or "COLLABORATOR". Review bodies carry no
+	// author association, so this never matches commands in them.
+	AuthorAssociations []string
+}
+
+func (req CommandRequirement) isEmpty() bool {
+	return req.Permission == PermissionNone &&
+		len(req.Orgs) == 0 &&
+		len(req.Teams) == 0 &&
+		!req.Author &&
+		len(req.AuthorAssociations) == 0
+}
+
+// allowed tells whether the commenter of inv satisfies the requirement.
+// Conditions which need no API call are checked first. Without a client
+// the conditions which need one are logged and treated as unsatisfied.
+func (r *CommandRouter) allowed(ctx context.Context, req CommandRequirement, inv *CommandInvocation) (bool, error) {
+	if req.isEmpty() {
+		return true, nil
+	}
+	login := inv.Commenter.GetLogin()
+	if req.Author && strings.EqualFold(login, inv.author()) {
+		return true, nil
+	}
+	for _, assoc := range req.AuthorAssociations {
+		if inv.AuthorAssociation != "" && strings.EqualFold(assoc, inv.AuthorAssociation) {
+			return true, nil
+		}
+	}
+	if req.Permission == PermissionNone && len(req.Orgs) == 0 && len(req.Teams) == 0 {
+		return false, nil
+	}
+
+	client := r.client(ctx)
+	if client == nil {
+		r.log().Printf("denying command %s of %s: its requirement needs a GitHub client", inv.Name, login)
+		return false, nil
+	}
+	if req.Permission != PermissionNone {
+		lv, err := r.permissions.permissionLevel(ctx, client, inv.Owner, inv.Repo, login)
+		if err != nil {
+			return false, err
+		}
+		if lv >= req.Permission {
+			return true, nil
+		}
+	}
+	for _, org := range req.Orgs {
+		ok, err := r.permissions.isOrgMember(ctx, client, org, login)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	for _, team := range req.Teams {
+		ok, err := r.permissions.isTeamMember(ctx, client, team, login)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (inv *CommandInvocation) author() string {
+	if inv.Issue != nil {
+		return inv.Issue.GetUser().GetLogin()
+	}
+	return inv.PullRequest.GetUser().GetLogin()
+}
+
+// deny tells the commenter that the command was refused, as configured.
+func (r *CommandRouter) deny(ctx context.Context, inv *CommandInvocation) error {
+	client := r.client(ctx)
+	if client == nil || (r.cfg.DeniedReply == "" && r.cfg.DeniedReaction == "") {
+		return nil
+	}
+	if r.cfg.DeniedReaction != "" {
+		if err := react(ctx, client, inv, r.cfg.DeniedReaction); err != nil {
+			return err
+		}
+	}
+	if r.cfg.DeniedReply != "" {
+		tmpl, err := template.New("denied").Parse(r.cfg.DeniedReply)
+		if err != nil {
+			return xerrors.Errorf("error parsing denied reply: %w", err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, inv); err != nil {
+			return xerrors.Errorf("error rendering denied reply: %w", err)
+		}
+		if err := reply(ctx, client, inv, buf.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// react adds a reaction to the comment the command was found in. Reviews
+// cannot have reactions, so nothing is done for them.
+func react(ctx context.Context, client *github.Client, inv *CommandInvocation, content string) error {
+	var err error
+	switch inv.Source {
+	case IssueCommentSource:
+		_, _, err = client.Reactions.CreateIssueCommentReaction(ctx, inv.Owner, inv.Repo, inv.CommentID, content)
+	case PullRequestReviewCommentSource:
+		_, _, err = client.Reactions.CreatePullRequestCommentReaction(ctx, inv.Owner, inv.Repo, inv.CommentID, content)
+	}
+	if err != nil {
+		return xerrors.Errorf("error adding reaction: %w", err)
+	}
+	return nil
+}
+
+// reply answers the comment the command was found in. Review comments are
+// answered in their thread, everything else on the issue or pull request.
+func reply(ctx context.Context, client *github.Client, inv *CommandInvocation, body string) error {
+	var err error
+	if inv.Source == PullRequestReviewCommentSource {
+		_, _, err = client.PullRequests.CreateCommentInReplyTo(ctx, inv.Owner, inv.Repo, inv.Number, body, inv.CommentID)
+	} else {
+		_, _, err = client.Issues.CreateComment(ctx, inv.Owner, inv.Repo, inv.Number, &github.IssueComment{
+			Body: github.String(body),
+		})
+	}
+	if err != nil {
+		return xerrors.Errorf("error replying to command: %w", err)
+	}
+	return nil
+}
+
+type permissionCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// permissionCache caches the results of permission and membership
+// lookups for ttl. Expired entries are pruned at most once per ttl, when
+// a lookup is stored.
+type permissionCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	entries   map[string]permissionCacheEntry
+	nextPrune time.Time
+	now       func() time.Time
+}
+
+func newPermissionCache(ttl time.Duration) *permissionCache {
+	return &permissionCache{
+		ttl:     ttl,
+		entries: map[string]permissionCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+func (c *permissionCache) get(key string, lookup func() (interface{}, error)) (interface{}, error) {
+	now := c.now()
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.value, nil
+	}
+
+	v, err := lookup()
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !now.Before(c.nextPrune) {
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.nextPrune = now.Add(c.ttl)
+	}
+	c.entries[key] = permissionCacheEntry{value: v, expires: now.Add(c.ttl)}
+	return v, nil
+}
+
+// collaboratorPermission is the response of the collaborator permission
+// endpoint. It is requested directly rather than through
+// Repositories.GetPermissionLevel because go-github v25 does not model
+// role_name, the only field which tells triage and maintain apart.
+type collaboratorPermission struct {
+	Permission string `json:"permission"`
+	RoleName   string `json:"role_name"`
+}
+
+func (c *permissionCache) permissionLevel(ctx context.Context, client *github.Client, owner, repo, user string) (PermissionLevel, error) {
+	key := strings.ToLower(fmt.Sprintf("permission:%s/%s:%s", owner, repo, user))
+	v, err := c.get(key, func() (interface{}, error) {
+		u := fmt.Sprintf("repos/%s/%s/collaborators/%s/permission", owner, repo, user)
+		req, err := client.NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		var perm collaboratorPermission
+		if resp, err := client.Do(ctx, req, &perm); err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return PermissionNone, nil
+			}
+			return nil, xerrors.Errorf("error getting permission level of %s: %w", user, err)
+		}
+		if lv, err := ParsePermissionLevel(perm.RoleName); err == nil {
+			return lv, nil
+		}
+		lv, _ := ParsePermissionLevel(perm.Permission)
+		return lv, nil
+	})
+	if err != nil {
+		return PermissionNone, err
+	}
+	return v.(PermissionLevel), nil
+}
+
+func (c *permissionCache) isOrgMember(ctx context.Context, client *github.Client, org, user string) (bool, error) {
+	key := strings.ToLower(fmt.Sprintf("org:%s:%s", org, user))
+	v, err := c.get(key, func() (interface{}, error) {
+		ok, _, err := client.Organizations.IsMember(ctx, org, user)
+		if err != nil {
+			return nil, xerrors.Errorf("error checking membership of %s in %s: %w", user, org, err)
+		}
+		return ok, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return v.(bool), nil
+}
+
+func (c *permissionCache) isTeamMember(ctx context.Context, client *github.Client, team, user string) (bool, error) {
+	key := strings.ToLower(fmt.Sprintf("team:%s:%s", team, user))
+	v, err := c.get(key, func() (interface{}, error) {
+		i := strings.Index(team, "/")
+		if i < 0 {
+			return nil, xerrors.Errorf("team must be written as org/team-slug: %s", team)
+		}
+		u := fmt.Sprintf("orgs/%s/teams/%s/memberships/%s", team[:i], team[i+1:], user)
+		req, err := client.NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		var membership github.Membership
+		if resp, err := client.Do(ctx, req, &membership); err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return false, nil
+			}
+			return nil, xerrors.Errorf("error checking membership of %s in %s: %w", user, team, err)
+		}
+		return membership.GetState() == "active", nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return v.(bool), nil
+}
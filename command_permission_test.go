@@ -0,0 +1,189 @@
+package ghbot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+)
+
+// permissionServer answers permission and membership lookups of a few
+// users, and counts them.
+func permissionServer() (*httptest.Server, func() int) {
+	var (
+		mu       sync.Mutex
+		requests int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/repos/octocat/hello-world/collaborators/alice/permission":
+			fmt.Fprint(w, `{"permission":"write","role_name":"maintain"}`)
+		case "/repos/octocat/hello-world/collaborators/bob/permission":
+			fmt.Fprint(w, `{"permission":"read","role_name":"triage"}`)
+		case "/repos/octocat/hello-world/collaborators/carol/permission":
+			// older servers have no role_name
+			fmt.Fprint(w, `{"permission":"admin"}`)
+		case "/orgs/acme/members/dave":
+			w.WriteHeader(http.StatusNoContent)
+		case "/orgs/acme/teams/core/memberships/erin":
+			fmt.Fprint(w, `{"state":"active"}`)
+		case "/orgs/acme/teams/core/memberships/frank":
+			fmt.Fprint(w, `{"state":"pending"}`)
+		case "/repos/octocat/hello-world/collaborators/broken/permission":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return srv, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+func permissionInvocation(commenter, author, association string) *CommandInvocation {
+	return &CommandInvocation{
+		Name:              "deploy",
+		Owner:             "octocat",
+		Repo:              "hello-world",
+		Issue:             &github.Issue{User: &github.User{Login: github.String(author)}},
+		Commenter:         &github.User{Login: github.String(commenter)},
+		AuthorAssociation: association,
+	}
+}
+
+func TestCommandRequirementAllowed(t *testing.T) {
+	srv, requests := permissionServer()
+	defer srv.Close()
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	tests := []struct {
+		name        string
+		req         CommandRequirement
+		commenter   string
+		author      string
+		association string
+		want        bool
+		wantErr     bool
+		lookups     int
+	}{
+		{name: "empty requirement", commenter: "nobody", want: true},
+		{name: "author", req: CommandRequirement{Author: true}, commenter: "Nobody", author: "nobody", want: true},
+		{name: "not the author", req: CommandRequirement{Author: true}, commenter: "nobody", author: "octocat", want: false},
+		{name: "association", req: CommandRequirement{AuthorAssociations: []string{"MEMBER", "OWNER"}}, commenter: "nobody", association: "owner", want: true},
+		{name: "other association", req: CommandRequirement{AuthorAssociations: []string{"OWNER"}}, commenter: "nobody", association: "CONTRIBUTOR", want: false},
+		{name: "no association", req: CommandRequirement{AuthorAssociations: []string{""}}, commenter: "nobody", want: false},
+		{name: "author before lookups", req: CommandRequirement{Author: true, Permission: PermissionAdmin}, commenter: "nobody", author: "nobody", want: true},
+		{name: "maintain role", req: CommandRequirement{Permission: PermissionMaintain}, commenter: "alice", want: true, lookups: 1},
+		{name: "maintain role below admin", req: CommandRequirement{Permission: PermissionAdmin}, commenter: "alice", want: false, lookups: 1},
+		{name: "triage role", req: CommandRequirement{Permission: PermissionTriage}, commenter: "bob", want: true, lookups: 1},
+		{name: "triage role below write", req: CommandRequirement{Permission: PermissionWrite}, commenter: "bob", want: false, lookups: 1},
+		{name: "permission without role", req: CommandRequirement{Permission: PermissionAdmin}, commenter: "carol", want: true, lookups: 1},
+		{name: "not a collaborator", req: CommandRequirement{Permission: PermissionRead}, commenter: "nobody", want: false, lookups: 1},
+		{name: "org member", req: CommandRequirement{Orgs: []string{"other", "acme"}}, commenter: "dave", want: true, lookups: 2},
+		{name: "not an org member", req: CommandRequirement{Orgs: []string{"acme"}}, commenter: "nobody", want: false, lookups: 1},
+		{name: "team member", req: CommandRequirement{Teams: []string{"acme/core"}}, commenter: "erin", want: true, lookups: 1},
+		{name: "pending team member", req: CommandRequirement{Teams: []string{"acme/core"}}, commenter: "frank", want: false, lookups: 1},
+		{name: "malformed team", req: CommandRequirement{Teams: []string{"core"}}, commenter: "erin", wantErr: true},
+		{name: "any condition", req: CommandRequirement{Permission: PermissionAdmin, Orgs: []string{"acme"}, Teams: []string{"acme/core"}}, commenter: "erin", want: true, lookups: 3},
+		{name: "lookup failure", req: CommandRequirement{Permission: PermissionRead}, commenter: "broken", wantErr: true, lookups: 1},
+	}
+	for _, tt := range tests {
+		// a fresh router so that nothing is cached
+		router := NewCommandRouter(CommandRouterConfig{Client: client})
+		before := requests()
+		ok, err := router.allowed(context.Background(), tt.req, permissionInvocation(tt.commenter, tt.author, tt.association))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v", tt.name, err)
+			continue
+		}
+		if ok != tt.want {
+			t.Errorf("%s: allowed = %v, want %v", tt.name, ok, tt.want)
+		}
+		if n := requests() - before; tt.lookups > 0 && n != tt.lookups {
+			t.Errorf("%s: %d lookups, want %d", tt.name, n, tt.lookups)
+		}
+	}
+}
+
+func TestCommandRequirementWithoutClient(t *testing.T) {
+	var logger lineLogger
+	router := NewCommandRouter(CommandRouterConfig{})
+	router.logger = func() Logger { return &logger }
+
+	inv := permissionInvocation("alice", "octocat", "")
+	ok, err := router.allowed(context.Background(), CommandRequirement{Permission: PermissionRead}, inv)
+	if ok || err != nil {
+		t.Errorf("allowed = %v, %v, want a denial", ok, err)
+	}
+	if len(logger.lines) != 1 || !strings.Contains(logger.lines[0], "needs a GitHub client") {
+		t.Errorf("logged %q", logger.lines)
+	}
+
+	// conditions which need no client still allow
+	ok, err = router.allowed(context.Background(), CommandRequirement{Permission: PermissionRead, Author: true}, permissionInvocation("octocat", "octocat", ""))
+	if !ok || err != nil {
+		t.Errorf("allowed = %v, %v for the author", ok, err)
+	}
+}
+
+func TestPermissionCache(t *testing.T) {
+	const ttl = time.Minute
+	now := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	c := newPermissionCache(ttl)
+	c.now = func() time.Time { return now }
+
+	lookups := 0
+	get := func(key string) interface{} {
+		v, err := c.get(key, func() (interface{}, error) {
+			lookups++
+			return lookups, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	if v := get("a"); v != 1 {
+		t.Errorf("first lookup = %v", v)
+	}
+	now = now.Add(ttl - time.Second)
+	if v := get("a"); v != 1 {
+		t.Errorf("lookup within the ttl = %v, want the cached 1", v)
+	}
+	now = now.Add(time.Second)
+	if v := get("a"); v != 2 {
+		t.Errorf("lookup after the ttl = %v, want 2", v)
+	}
+
+	// failed lookups are not cached
+	if _, err := c.get("b", func() (interface{}, error) { return nil, fmt.Errorf("failed") }); err == nil {
+		t.Error("error of the lookup was not returned")
+	}
+	if _, ok := c.entries["b"]; ok {
+		t.Error("failed lookup was cached")
+	}
+
+	// expired entries are pruned as others are stored
+	for i := 0; i < 10; i++ {
+		get(fmt.Sprintf("user%d", i))
+	}
+	now = now.Add(2 * ttl)
+	get("c")
+	if len(c.entries) != 1 {
+		t.Errorf("%d entries cached, want only the one stored last", len(c.entries))
+	}
+}
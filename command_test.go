@@ -0,0 +1,182 @@
+package ghbot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+func TestParseCommandLines(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []commandLine
+	}{
+		{
+			name: "single",
+			body: "/label bug",
+			want: []commandLine{{name: "label", args: []string{"bug"}, rawArgs: "bug"}},
+		},
+		{
+			name: "several",
+			body: "LGTM\r\n/Approve\r\n  /label bug  enhancement\r\nthanks",
+			want: []commandLine{
+				{name: "approve"},
+				{name: "label", args: []string{"bug", "enhancement"}, rawArgs: "bug  enhancement"},
+			},
+		},
+		{
+			name: "fenced code",
+			body: "```\n/label bug\n```\n~~~~sh\n/label bug\n~~~\n/label bug\n~~~~\n/assign",
+			want: []commandLine{{name: "assign"}},
+		},
+		{
+			name: "unclosed fence",
+			body: "```go\n/label bug",
+		},
+		{
+			name: "indented code",
+			body: "    /label bug\n\t/label bug\n   /assign",
+			want: []commandLine{{name: "assign"}},
+		},
+		{
+			name: "quoted reply",
+			body: "> /label bug\n>/label bug\n /assign",
+			want: []commandLine{{name: "assign"}},
+		},
+		{
+			name: "not a command",
+			body: "see /label\n/\n/-label\n/label/bug\nhttps://example.com/label",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseCommandLines(tt.body, "/")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseCommandLines(%q) = %#v, want %#v", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{`bug`, []string{"bug"}},
+		{`bug  enhancement	help`, []string{"bug", "enhancement", "help"}},
+		{`"good first issue" bug`, []string{"good first issue", "bug"}},
+		{`'good first issue'`, []string{"good first issue"}},
+		{`'say "hi"' "it's"`, []string{`say "hi"`, "it's"}},
+		{`"a \"quoted\" word"`, []string{`a "quoted" word`}},
+		{`'a \ b'`, []string{`a \ b`}},
+		{`good\ first\ issue`, []string{"good first issue"}},
+		{`""`, []string{""}},
+		{`area/"ci tools"`, []string{"area/ci tools"}},
+		{`"unterminated`, []string{"unterminated"}},
+	}
+	for _, tt := range tests {
+		if got := splitArgs(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitArgs(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAddCommandRouterRemove(t *testing.T) {
+	bot := New(Config{})
+	h := bot.AddCommandRouter(NewCommandRouter(CommandRouterConfig{}))
+	for _, typ := range []string{"issue_comment", "pull_request_review_comment", "pull_request_review"} {
+		if n := len(bot.eventHooks[typ].entries); n != 1 {
+			t.Errorf("%d %s hooks registered, want 1", n, typ)
+		}
+	}
+	h.Remove()
+	for _, typ := range []string{"issue_comment", "pull_request_review_comment", "pull_request_review"} {
+		if n := len(bot.eventHooks[typ].entries); n != 0 {
+			t.Errorf("%d %s hooks left after removing the router", n, typ)
+		}
+	}
+}
+
+func TestAckReactionsBestEffort(t *testing.T) {
+	var reactions int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&reactions, 1)
+		http.Error(w, `{"message":"Server Error"}`, http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	router := NewCommandRouter(CommandRouterConfig{Client: client, AckReactions: true})
+	inv := CommandInvocation{
+		Name:      "retest",
+		Source:    IssueCommentSource,
+		Owner:     "octocat",
+		Repo:      "hello-world",
+		CommentID: 1,
+	}
+
+	ran := false
+	cmd := Command{Name: "retest", Handler: func(context.Context, *CommandInvocation) error {
+		ran = true
+		return nil
+	}}
+	if err := router.run(context.Background(), cmd, &inv); err != nil {
+		t.Errorf("failed reactions made the command fail: %+v", err)
+	}
+	if !ran {
+		t.Error("failed reaction on receipt aborted the command")
+	}
+
+	errHandler := errors.New("handler failed")
+	cmd.Handler = func(context.Context, *CommandInvocation) error { return errHandler }
+	if err := router.run(context.Background(), cmd, &inv); err != errHandler {
+		t.Errorf("run returned %v, want the error of the handler", err)
+	}
+	if n := atomic.LoadInt64(&reactions); n != 4 {
+		t.Errorf("%d reactions attempted, want 4", n)
+	}
+}
+
+func TestDispatchRunsEveryCommand(t *testing.T) {
+	errFail := errors.New("failed")
+	var ran []string
+	router := NewCommandRouter(CommandRouterConfig{DisableSuggestions: true})
+	for _, name := range []string{"fail", "ok"} {
+		name := name
+		router.AddCommand(Command{Name: name, Handler: func(_ context.Context, inv *CommandInvocation) error {
+			ran = append(ran, inv.Name+" "+inv.RawArgs)
+			if name == "fail" {
+				return errFail
+			}
+			return nil
+		}})
+	}
+
+	err := router.dispatch(context.Background(), CommandInvocation{
+		Source: IssueCommentSource,
+		Owner:  "octocat",
+		Repo:   "hello-world",
+		Body:   "/fail 1\n/unknown\n/ok 2\n/fail 3",
+	})
+	if want := []string{"fail 1", "ok 2", "fail 3"}; !reflect.DeepEqual(ran, want) {
+		t.Errorf("ran %q, want %q", ran, want)
+	}
+	errs, ok := err.(CommandErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("dispatch returned %+v, want the errors of both failed commands", err)
+	}
+	if !xerrors.Is(err, errFail) {
+		t.Errorf("%v does not match the error of the handler", err)
+	}
+}
@@ -0,0 +1,87 @@
+package ghbot
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"time"
+)
+
+// DeliveryMetadata describes the webhook delivery an event came from, as
+// given by the headers GitHub sends along with the payload.
+type DeliveryMetadata struct {
+	// ID is the GUID of the delivery, from X-GitHub-Delivery.
+	ID string
+	// Event is the event type, from X-GitHub-Event.
+	Event string
+	// HookID is the ID of the webhook which sent the delivery.
+	HookID string
+	// InstallationTargetType and InstallationTargetID identify the
+	// repository, organization or App the webhook belongs to.
+	InstallationTargetType string
+	InstallationTargetID   string
+	// EnterpriseHost and EnterpriseVersion are only sent by GitHub
+	// Enterprise Server.
+	EnterpriseHost    string
+	EnterpriseVersion string
+	ReceivedAt        time.Time
+}
+
+// IsEnterprise tells whether the delivery was sent by GitHub Enterprise
+// Server.
+func (md *DeliveryMetadata) IsEnterprise() bool {
+	return md.EnterpriseHost != "" || md.EnterpriseVersion != ""
+}
+
+func newDeliveryMetadata(r *http.Request, receivedAt time.Time) *DeliveryMetadata {
+	return &DeliveryMetadata{
+		ID:                     r.Header.Get("X-GitHub-Delivery"),
+		Event:                  r.Header.Get("X-GitHub-Event"),
+		HookID:                 r.Header.Get("X-GitHub-Hook-ID"),
+		InstallationTargetType: r.Header.Get("X-GitHub-Hook-Installation-Target-Type"),
+		InstallationTargetID:   r.Header.Get("X-GitHub-Hook-Installation-Target-ID"),
+		EnterpriseHost:         r.Header.Get("X-GitHub-Enterprise-Host"),
+		EnterpriseVersion:      r.Header.Get("X-GitHub-Enterprise-Version"),
+		ReceivedAt:             receivedAt,
+	}
+}
+
+type deliveryContextKey struct{}
+
+// DeliveryFromContext returns the metadata of the delivery a hook is
+// called for, or nil when the event did not come from a webhook delivery.
+func DeliveryFromContext(ctx context.Context) *DeliveryMetadata {
+	md, _ := ctx.Value(deliveryContextKey{}).(*DeliveryMetadata)
+	return md
+}
+
+func withDelivery(ctx context.Context, md *DeliveryMetadata) context.Context {
+	return context.WithValue(ctx, deliveryContextKey{}, md)
+}
+
+// SignPayload returns the values of the X-Hub-Signature and
+// X-Hub-Signature-256 headers GitHub sends along with payload when the
+// webhook has secret.
+func SignPayload(secret string, payload []byte) (signature, signature256 string) {
+	return "sha1=" + hexHMAC(sha1.New, secret, payload), "sha256=" + hexHMAC(sha256.New, secret, payload)
+}
+
+func hexHMAC(h func() hash.Hash, secret string, payload []byte) string {
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewDeliveryID returns a random GUID like the ones GitHub sends in
+// X-GitHub-Delivery.
+func NewDeliveryID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
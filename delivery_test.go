@@ -0,0 +1,65 @@
+package ghbot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+)
+
+func TestSignPayload(t *testing.T) {
+	payload := []byte(`{"zen":"Keep it logically awesome."}`)
+	signature, signature256 := SignPayload("secret", payload)
+	for _, sig := range []string{signature, signature256} {
+		if err := github.ValidateSignature(sig, payload, []byte("secret")); err != nil {
+			t.Errorf("%s: %v", sig, err)
+		}
+		if err := github.ValidateSignature(sig, payload, []byte("other")); err == nil {
+			t.Errorf("%s is valid with another secret", sig)
+		}
+	}
+}
+
+func TestNewDeliveryMetadata(t *testing.T) {
+	receivedAt := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.Header.Set("X-GitHub-Delivery", "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	r.Header.Set("X-GitHub-Event", "push")
+	r.Header.Set("X-GitHub-Hook-ID", "292430182")
+	r.Header.Set("X-GitHub-Hook-Installation-Target-Type", "repository")
+	r.Header.Set("X-GitHub-Hook-Installation-Target-ID", "79929171")
+	r.Header.Set("X-GitHub-Enterprise-Host", "ghe.example.com")
+	r.Header.Set("X-GitHub-Enterprise-Version", "2.17.0")
+
+	md := newDeliveryMetadata(r, receivedAt)
+	want := &DeliveryMetadata{
+		ID:                     "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+		Event:                  "push",
+		HookID:                 "292430182",
+		InstallationTargetType: "repository",
+		InstallationTargetID:   "79929171",
+		EnterpriseHost:         "ghe.example.com",
+		EnterpriseVersion:      "2.17.0",
+		ReceivedAt:             receivedAt,
+	}
+	if !reflect.DeepEqual(md, want) {
+		t.Errorf("metadata = %+v, want %+v", *md, *want)
+	}
+	if !md.IsEnterprise() {
+		t.Error("delivery with X-GitHub-Enterprise-* headers is not from GitHub Enterprise Server")
+	}
+
+	// github.com sends neither header
+	r.Header.Del("X-GitHub-Enterprise-Host")
+	r.Header.Del("X-GitHub-Enterprise-Version")
+	if md := newDeliveryMetadata(r, receivedAt); md.IsEnterprise() || md.EnterpriseHost != "" || md.EnterpriseVersion != "" {
+		t.Errorf("metadata of a github.com delivery = %+v", *md)
+	}
+	r.Header.Set("X-GitHub-Enterprise-Version", "2.17.0")
+	if md := newDeliveryMetadata(r, receivedAt); !md.IsEnterprise() {
+		t.Error("delivery with only X-GitHub-Enterprise-Version is not from GitHub Enterprise Server")
+	}
+}
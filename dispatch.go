@@ -0,0 +1,171 @@
+package ghbot
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"golang.org/x/xerrors"
+)
+
+// HookErrors holds the errors returned by hooks which were run
+// concurrently for a single event.
+type HookErrors []error
+
+func (errs HookErrors) Error() string {
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Is reports whether any of the errors matches target, so that
+// xerrors.Is sees the errors of hooks run in parallel as it sees the
+// error of a hook run sequentially.
+func (errs HookErrors) Is(target error) bool {
+	for _, err := range errs {
+		if xerrors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the errors which xerrors.As can assign to target.
+func (errs HookErrors) As(target interface{}) bool {
+	for _, err := range errs {
+		if xerrors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetParallelDispatch makes the hooks of the event type run concurrently,
+// at most maxConcurrency at a time. Hooks which have a priority, are
+// ordered with Before or After, or are referred to by another hook's
+// ordering still run sequentially in their resolved order, as one unit
+// alongside the others. When maxConcurrency is zero or less, the event
+// type falls back to sequential dispatch.
+func (bot *Bot) SetParallelDispatch(eventType string, maxConcurrency int) {
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+
+	if maxConcurrency <= 0 {
+		delete(bot.parallelDispatch, eventType)
+		return
+	}
+	bot.parallelDispatch[eventType] = maxConcurrency
+}
+
+// HookResult is the outcome of a single hook run for an event.
+type HookResult struct {
+	// Hook is the name the hook was registered with, or "#<id>" when it
+	// has none.
+	Hook string
+	Err  error
+}
+
+type hookObserverContextKey struct{}
+
+// WithHookObserver returns a copy of ctx which makes the bot report the
+// result of every hook it runs for an event handled with ctx. observe is
+// called concurrently when the event type has parallel dispatch.
+func WithHookObserver(ctx context.Context, observe func(HookResult)) context.Context {
+	return context.WithValue(ctx, hookObserverContextKey{}, observe)
+}
+
+type hookCall func(ctx context.Context, hook interface{}) error
+
+func (bot *Bot) runEventHooks(ctx context.Context, eventType string, call hookCall) error {
+	bot.mu.Lock()
+	var entries []*hookEntry
+	if list, ok := bot.eventHooks[eventType]; ok {
+		entries = list.entries
+	}
+	maxConcurrency := bot.parallelDispatch[eventType]
+	bot.mu.Unlock()
+
+	if maxConcurrency > 0 && len(entries) > 1 {
+		return bot.runHooksParallel(ctx, entries, maxConcurrency, call)
+	}
+	return bot.runHooks(ctx, entries, call)
+}
+
+func (bot *Bot) runHooks(ctx context.Context, entries []*hookEntry, call hookCall) error {
+	observe, _ := ctx.Value(hookObserverContextKey{}).(func(HookResult))
+	for _, entry := range entries {
+		hookCtx := ctx
+		if entry.dryRun {
+			hookCtx = WithDryRun(ctx)
+		}
+		err := call(hookCtx, entry.hook)
+		if observe != nil {
+			observe(HookResult{Hook: entry.String(), Err: err})
+		}
+		if err != nil {
+			bot.logger.Printf("error on hook: %+v", err)
+			return xerrors.Errorf("error on hook: %w", err)
+		}
+	}
+	return nil
+}
+
+func (bot *Bot) runHooksParallel(ctx context.Context, entries []*hookEntry, maxConcurrency int, call hookCall) error {
+	referenced := map[string]bool{}
+	for _, e := range entries {
+		for _, name := range e.before {
+			referenced[name] = true
+		}
+		for _, name := range e.after {
+			referenced[name] = true
+		}
+	}
+	var sequential, independent []*hookEntry
+	for _, e := range entries {
+		if e.priority != 0 || len(e.before) > 0 || len(e.after) > 0 || (e.name != "" && referenced[e.name]) {
+			sequential = append(sequential, e)
+			continue
+		}
+		independent = append(independent, e)
+	}
+
+	tasks := make([]func() error, 0, len(independent)+1)
+	if len(sequential) > 0 {
+		tasks = append(tasks, func() error {
+			return bot.runHooks(ctx, sequential, call)
+		})
+	}
+	for _, e := range independent {
+		e := e
+		tasks = append(tasks, func() error {
+			return bot.runHooks(ctx, []*hookEntry{e}, call)
+		})
+	}
+
+	errs := make([]error, len(tasks))
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, task func() error) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = task()
+		}(i, task)
+	}
+	wg.Wait()
+
+	var hookErrs HookErrors
+	for _, err := range errs {
+		if err != nil {
+			hookErrs = append(hookErrs, err)
+		}
+	}
+	if len(hookErrs) > 0 {
+		return hookErrs
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package ghbot
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+func TestParallelDispatchConcurrency(t *testing.T) {
+	const hooks, maxConcurrency = 8, 3
+
+	bot := New(Config{})
+	bot.SetParallelDispatch("push", maxConcurrency)
+	var (
+		mu            sync.Mutex
+		running, peak int
+		ran           int
+		release       = make(chan struct{})
+		started       = make(chan struct{})
+		startedOnce   sync.Once
+	)
+	for i := 0; i < hooks; i++ {
+		bot.AddPushEventHook(func(context.Context, *github.PushEvent) error {
+			mu.Lock()
+			running++
+			ran++
+			if running > peak {
+				peak = running
+			}
+			if running == maxConcurrency {
+				startedOnce.Do(func() { close(started) })
+			}
+			mu.Unlock()
+
+			<-release
+			mu.Lock()
+			running--
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- bot.handleWebHookPayload(context.Background(), "push", []byte(`{}`)) }()
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("hooks did not run concurrently")
+	}
+	// give further hooks the chance to exceed the limit
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if peak != maxConcurrency {
+		t.Errorf("%d hooks ran at once, want %d", peak, maxConcurrency)
+	}
+	if ran != hooks {
+		t.Errorf("%d hooks ran, want %d", ran, hooks)
+	}
+}
+
+func TestParallelDispatchOrderedHooks(t *testing.T) {
+	bot := New(Config{})
+	bot.SetParallelDispatch("push", 4)
+	var (
+		mu  sync.Mutex
+		ran []string
+	)
+	hook := func(name string, delay time.Duration) PushEventHook {
+		return func(context.Context, *github.PushEvent) error {
+			time.Sleep(delay)
+			mu.Lock()
+			defer mu.Unlock()
+			ran = append(ran, name)
+			return nil
+		}
+	}
+	// the constrained hooks run one after another in their resolved
+	// order, even though the first ones are slowest
+	bot.AddPushEventHook(hook("c", 0), WithName("c"), After("b"))
+	bot.AddPushEventHook(hook("a", 30*time.Millisecond), WithName("a"), WithPriority(1))
+	bot.AddPushEventHook(hook("b", 20*time.Millisecond), WithName("b"))
+	bot.AddPushEventHook(hook("free", 0))
+
+	if err := bot.handleWebHookPayload(context.Background(), "push", []byte(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+	var ordered []string
+	for _, name := range ran {
+		if name != "free" {
+			ordered = append(ordered, name)
+		}
+	}
+	if got := strings.Join(ordered, ", "); got != "a, b, c" {
+		t.Errorf("ordered hooks ran as %s", got)
+	}
+	// the independent hook did not wait for them
+	if ran[0] != "free" {
+		t.Errorf("hooks ran as %v", ran)
+	}
+}
+
+type hookFailure struct{ hook string }
+
+func (e *hookFailure) Error() string { return e.hook + " failed" }
+
+func TestParallelDispatchErrors(t *testing.T) {
+	errFirst := xerrors.New("first failed")
+
+	bot := New(Config{})
+	bot.SetParallelDispatch("push", 2)
+	var (
+		mu  sync.Mutex
+		ran []string
+	)
+	add := func(name string, err error, opts ...HookOption) {
+		bot.AddPushEventHook(func(context.Context, *github.PushEvent) error {
+			mu.Lock()
+			ran = append(ran, name)
+			mu.Unlock()
+			return err
+		}, opts...)
+	}
+	add("first", errFirst, WithName("first"))
+	// follows the failing hook in the sequential group, so it is skipped
+	add("second", nil, After("first"))
+	add("third", &hookFailure{"third"})
+	add("fourth", nil)
+
+	err := bot.handleWebHookPayload(context.Background(), "push", []byte(`{}`))
+	var errs HookErrors
+	if !xerrors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("error = %+v, want HookErrors of 2", err)
+	}
+	if !xerrors.Is(err, errFirst) {
+		t.Errorf("%v does not match the error of the first hook", err)
+	}
+	var failure *hookFailure
+	if !xerrors.As(err, &failure) || failure.hook != "third" {
+		t.Errorf("%v does not hold the error of the third hook", err)
+	}
+	if xerrors.Is(err, xerrors.New("first failed")) {
+		t.Errorf("%v matches an unrelated error", err)
+	}
+	sort.Strings(ran)
+	if got := strings.Join(ran, ", "); got != "first, fourth, third" {
+		t.Errorf("ran %s", got)
+	}
+}
+
+func TestParallelDispatchSequentialFallback(t *testing.T) {
+	errHook := xerrors.New("hook failed")
+	for _, maxConcurrency := range []int{0, 4} {
+		bot := New(Config{})
+		bot.SetParallelDispatch("push", 4)
+		bot.SetParallelDispatch("push", maxConcurrency)
+		bot.AddPushEventHook(func(context.Context, *github.PushEvent) error { return errHook })
+		bot.AddPushEventHook(func(context.Context, *github.PushEvent) error { return nil })
+		// the error matches whether the hooks ran sequentially or not
+		if err := bot.handleWebHookPayload(context.Background(), "push", []byte(`{}`)); !xerrors.Is(err, errHook) {
+			t.Errorf("max concurrency %d: error = %v", maxConcurrency, err)
+		}
+	}
+}
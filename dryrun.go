@@ -0,0 +1,267 @@
+package ghbot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+)
+
+// DryRun makes the hook run in dry-run mode: the GitHub API requests it
+// makes with the context it is given only read. See SetDryRun.
+func DryRun() HookOption {
+	return func(opts *hookOptions) {
+		opts.dryRun = true
+	}
+}
+
+type dryRunContextKey struct{}
+
+// WithDryRun returns a copy of ctx with which the clients of the bot do
+// not change anything on GitHub. See SetDryRun.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunContextKey{}, true)
+}
+
+type eventRepositoryContextKey struct{}
+
+func withEventRepository(ctx context.Context, fullName string) context.Context {
+	return context.WithValue(ctx, eventRepositoryContextKey{}, fullName)
+}
+
+// SetDryRun turns on dry-run mode for the repositories, written as
+// "owner/name" or "owner/*". The clients the bot creates send GET
+// requests as usual, but log mutating requests with their payload and
+// answer them with a synthetic success response instead of sending them.
+// Requests to other endpoints than the repository's count as made for
+// the repository of the event being handled. Calling SetDryRun without
+// repositories turns the mode off again, except for hooks registered with
+// DryRun. Clients of a factory set with SetClientFactory are not
+// affected.
+func (bot *Bot) SetDryRun(repos ...string) {
+	bot.dryRun.mu.Lock()
+	defer bot.dryRun.mu.Unlock()
+
+	bot.dryRun.repos = append([]string(nil), repos...)
+}
+
+type dryRunTransport struct {
+	base   http.RoundTripper
+	logger func() Logger
+
+	mu    sync.Mutex
+	repos []string
+}
+
+type dryRunLog struct {
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Size   int             `json:"size,omitempty"`
+}
+
+func (l dryRunLog) String() string {
+	b, err := json.Marshal(l)
+	if err != nil {
+		panic(err)
+	}
+	return "dry-run: " + string(b)
+}
+
+// requestRepository returns "owner/name" when the request is made to an
+// endpoint under /repos/.
+func requestRepository(req *http.Request) string {
+	p := strings.TrimPrefix(req.URL.Path, "/api/v3")
+	segments := strings.Split(strings.TrimPrefix(p, "/"), "/")
+	if len(segments) < 3 || segments[0] != "repos" {
+		return ""
+	}
+	return segments[1] + "/" + segments[2]
+}
+
+func (t *dryRunTransport) enabled(req *http.Request) bool {
+	ctx := req.Context()
+	if dryRun, _ := ctx.Value(dryRunContextKey{}).(bool); dryRun {
+		return true
+	}
+	t.mu.Lock()
+	repos := t.repos
+	t.mu.Unlock()
+	if len(repos) == 0 {
+		return false
+	}
+	repo := requestRepository(req)
+	if repo == "" {
+		repo, _ = ctx.Value(eventRepositoryContextKey{}).(string)
+	}
+	for _, pattern := range repos {
+		if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(repo)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isMutating tells whether req changes anything. GraphQL queries only
+// read although they are POSTed.
+func isMutating(req *http.Request, body []byte) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	// exchanging installation tokens must keep working
+	if strings.HasSuffix(req.URL.Path, "/access_tokens") {
+		return false
+	}
+	if strings.HasSuffix(req.URL.Path, "/graphql") {
+		var q struct {
+			Query string `json:"query"`
+		}
+		if json.Unmarshal(body, &q) == nil && isGraphQLQuery(q.Query) {
+			return false
+		}
+	}
+	return true
+}
+
+// isGraphQLQuery tells whether every operation in a GraphQL document is a
+// query. Anything it cannot make sense of counts as a mutation, so that
+// dry-run mode fails closed.
+func isGraphQLQuery(doc string) bool {
+	depth := 0
+	definitions := 0
+	for i := 0; i < len(doc); {
+		c := doc[i]
+		switch {
+		case c == '#':
+			for i < len(doc) && doc[i] != '\n' && doc[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(doc[i:], `"""`):
+			end := -1
+			for j := i + 3; j+3 <= len(doc); j++ {
+				if doc[j] == '\\' && strings.HasPrefix(doc[j+1:], `"""`) {
+					j += 3
+					continue
+				}
+				if strings.HasPrefix(doc[j:], `"""`) {
+					end = j + 3
+					break
+				}
+			}
+			if end < 0 {
+				return false
+			}
+			i = end
+		case c == '"':
+			i++
+			for i < len(doc) && doc[i] != '"' {
+				if doc[i] == '\\' {
+					i++
+				}
+				if i < len(doc) && (doc[i] == '\n' || doc[i] == '\r') {
+					return false
+				}
+				i++
+			}
+			if i >= len(doc) {
+				return false
+			}
+			i++
+		case c == '{' || c == '(' || c == '[':
+			if depth == 0 && c == '{' {
+				definitions++
+			}
+			depth++
+			i++
+		case c == '}' || c == ')' || c == ']':
+			if depth--; depth < 0 {
+				return false
+			}
+			i++
+		case c == '_' || 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z':
+			j := i + 1
+			for j < len(doc) && (doc[j] == '_' || 'A' <= doc[j] && doc[j] <= 'Z' || 'a' <= doc[j] && doc[j] <= 'z' || '0' <= doc[j] && doc[j] <= '9') {
+				j++
+			}
+			// at the top level, words are keywords, names and type
+			// conditions of operations and fragments
+			if depth == 0 {
+				switch doc[i:j] {
+				case "mutation", "subscription":
+					return false
+				}
+			}
+			i = j
+		default:
+			i++
+		}
+	}
+	return depth == 0 && definitions > 0
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if req.Method == http.MethodGet || !t.enabled(req) {
+		return base.RoundTrip(req)
+	}
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+	if !isMutating(req, body) {
+		r := new(http.Request)
+		*r = *req
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		return base.RoundTrip(r)
+	}
+
+	entry := dryRunLog{Method: req.Method, URL: req.URL.String()}
+	if json.Valid(body) {
+		entry.Body = body
+	} else {
+		entry.Size = len(body)
+	}
+	t.logger().Println(entry.String())
+
+	resp := &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type":    {"application/json; charset=utf-8"},
+			"X-Ghbot-Dry-Run": {"true"},
+		},
+		Request: req,
+	}
+	// the response is empty rather than an echo of the request, which
+	// would not decode as the resource GitHub answers with
+	respBody := []byte("{}")
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		respBody = []byte("[]")
+	}
+	switch req.Method {
+	case http.MethodDelete:
+		resp.Status, resp.StatusCode = "204 No Content", http.StatusNoContent
+		respBody = nil
+	case http.MethodPost:
+		resp.Status, resp.StatusCode = "201 Created", http.StatusCreated
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+	resp.ContentLength = int64(len(respBody))
+	return resp, nil
+}
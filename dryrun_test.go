@@ -0,0 +1,160 @@
+package ghbot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type lineLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *lineLogger) log(s string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lines = append(l.lines, strings.TrimSpace(s))
+}
+
+func (l *lineLogger) Print(v ...interface{})                 { l.log(fmt.Sprint(v...)) }
+func (l *lineLogger) Printf(format string, v ...interface{}) { l.log(fmt.Sprintf(format, v...)) }
+func (l *lineLogger) Println(v ...interface{})               { l.log(fmt.Sprintln(v...)) }
+
+func TestDryRun(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		sent []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		fmt.Fprint(w, `{"number":1,"title":"hello"}`)
+	}))
+	defer srv.Close()
+
+	bot := New(Config{GitHubToken: "secret", EnterpriseBaseURL: srv.URL + "/api/v3/"})
+	var logger lineLogger
+	bot.SetLogger(&logger)
+	bot.SetDryRun("octocat/*")
+	client, err := bot.clientFactory(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	labels, _, err := client.Issues.AddLabelsToIssue(ctx, "octocat", "hello-world", 1, []string{"bug"})
+	if err != nil {
+		t.Errorf("error adding labels: %+v", err)
+	}
+	if len(labels) != 0 {
+		t.Errorf("labels = %v", labels)
+	}
+	if _, _, err := client.Issues.AddAssignees(ctx, "octocat", "hello-world", 1, []string{"octocat"}); err != nil {
+		t.Errorf("error adding assignees: %+v", err)
+	}
+	if _, err := client.Issues.RemoveLabelForIssue(ctx, "octocat", "hello-world", 1, "bug"); err != nil {
+		t.Errorf("error removing label: %+v", err)
+	}
+	// reads and other repositories are not affected
+	if _, _, err := client.Issues.Get(ctx, "octocat", "hello-world", 1); err != nil {
+		t.Errorf("error getting issue: %+v", err)
+	}
+	if _, _, err := client.Issues.Edit(ctx, "octo-org", "hello-world", 1, nil); err != nil {
+		t.Errorf("error editing issue: %+v", err)
+	}
+
+	want := []string{"GET /api/v3/repos/octocat/hello-world/issues/1", "PATCH /api/v3/repos/octo-org/hello-world/issues/1"}
+	if strings.Join(sent, "\n") != strings.Join(want, "\n") {
+		t.Errorf("sent %q, want %q", sent, want)
+	}
+	wantLogs := []string{
+		`dry-run: {"method":"POST","url":"` + srv.URL + `/api/v3/repos/octocat/hello-world/issues/1/labels","body":["bug"]}`,
+		`dry-run: {"method":"POST","url":"` + srv.URL + `/api/v3/repos/octocat/hello-world/issues/1/assignees","body":{"assignees":["octocat"]}}`,
+		`dry-run: {"method":"DELETE","url":"` + srv.URL + `/api/v3/repos/octocat/hello-world/issues/1/labels/bug"}`,
+	}
+	if strings.Join(logger.lines, "\n") != strings.Join(wantLogs, "\n") {
+		t.Errorf("logged\n%s\nwant\n%s", strings.Join(logger.lines, "\n"), strings.Join(wantLogs, "\n"))
+	}
+}
+
+func TestIsGraphQLQuery(t *testing.T) {
+	tests := []struct {
+		doc  string
+		want bool
+	}{
+		{`{ viewer { login } }`, true},
+		{`query { viewer { login } }`, true},
+		{`query Q($owner: String = "mutation") { repository(owner: $owner, name: "x") { id } }`, true},
+		{"# mutation\nquery { viewer { login } }", true},
+		{`fragment F on Mutation { id } query { ...F }`, true},
+		{`query { search(query: "mutation {", type: ISSUE) { issueCount } }`, true},
+		{`query { a(s: """ "mutation" \""" """) { id } }`, true},
+
+		{`mutation { addStar(input: {starrableId: "x"}) { clientMutationId } }`, false},
+		{"# query\nmutation { addStar(input: {}) { clientMutationId } }", false},
+		{"#comment\n  mutation M { addStar(input: {}) { clientMutationId } }", false},
+		{`fragment F on Starrable { id } mutation { addStar(input: {}) { starrable { ...F } } }`, false},
+		{`query A { viewer { login } } mutation B { addStar(input: {}) { clientMutationId } }`, false},
+		{`subscription { x }`, false},
+		{``, false},
+		{`# only a comment`, false},
+		{`query { viewer { login }`, false},
+		{`query { a(s: "unterminated) { id } }`, false},
+		{`} mutation {`, false},
+	}
+	for _, tt := range tests {
+		if got := isGraphQLQuery(tt.doc); got != tt.want {
+			t.Errorf("isGraphQLQuery(%q) = %v, want %v", tt.doc, got, tt.want)
+		}
+	}
+}
+
+func TestDryRunGraphQL(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		sent int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sent++
+		mu.Unlock()
+		fmt.Fprint(w, `{"data":{}}`)
+	}))
+	defer srv.Close()
+
+	rt := &dryRunTransport{base: http.DefaultTransport, logger: func() Logger { return nopLogger{} }}
+	for _, tt := range []struct {
+		body string
+		sent bool
+	}{
+		{`{"query":"query { viewer { login } }"}`, true},
+		{`{"query":"#x\nmutation { addStar(input: {}) { clientMutationId } }"}`, false},
+		{`[{"query":"query { viewer { login } }"}]`, false},
+		{`not json`, false},
+	} {
+		mu.Lock()
+		sent = 0
+		mu.Unlock()
+		req, err := http.NewRequest(http.MethodPost, srv.URL+"/api/graphql", strings.NewReader(tt.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := rt.RoundTrip(req.WithContext(WithDryRun(req.Context())))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		mu.Lock()
+		if (sent > 0) != tt.sent {
+			t.Errorf("%s was sent: %v, want %v", tt.body, sent > 0, tt.sent)
+		}
+		mu.Unlock()
+	}
+}
@@ -0,0 +1,995 @@
+package ghbot
+
+import (
+	"encoding/json"
+
+	"github.com/google/go-github/v25/github"
+)
+
+// The event types below are delivered by GitHub but are not modeled by
+// go-github v25, so ghbot defines them locally. They follow the shape of
+// the go-github event types so that hooks can treat them alike.
+
+// WorkflowRunEvent is triggered when a GitHub Actions workflow run is
+// requested, in progress or completed.
+// The Webhook event name is "workflow_run".
+type WorkflowRunEvent struct {
+	Action      *string      `json:"action,omitempty"`
+	Workflow    *Workflow    `json:"workflow,omitempty"`
+	WorkflowRun *WorkflowRun `json:"workflow_run,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// Workflow represents a GitHub Actions workflow.
+type Workflow struct {
+	ID        *int64            `json:"id,omitempty"`
+	NodeID    *string           `json:"node_id,omitempty"`
+	Name      *string           `json:"name,omitempty"`
+	Path      *string           `json:"path,omitempty"`
+	State     *string           `json:"state,omitempty"`
+	CreatedAt *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt *github.Timestamp `json:"updated_at,omitempty"`
+	URL       *string           `json:"url,omitempty"`
+	HTMLURL   *string           `json:"html_url,omitempty"`
+	BadgeURL  *string           `json:"badge_url,omitempty"`
+}
+
+// WorkflowRun represents a single run of a GitHub Actions workflow.
+type WorkflowRun struct {
+	ID             *int64                  `json:"id,omitempty"`
+	NodeID         *string                 `json:"node_id,omitempty"`
+	Name           *string                 `json:"name,omitempty"`
+	HeadBranch     *string                 `json:"head_branch,omitempty"`
+	HeadSHA        *string                 `json:"head_sha,omitempty"`
+	RunNumber      *int                    `json:"run_number,omitempty"`
+	RunAttempt     *int                    `json:"run_attempt,omitempty"`
+	Event          *string                 `json:"event,omitempty"`
+	Status         *string                 `json:"status,omitempty"`
+	Conclusion     *string                 `json:"conclusion,omitempty"`
+	WorkflowID     *int64                  `json:"workflow_id,omitempty"`
+	CheckSuiteID   *int64                  `json:"check_suite_id,omitempty"`
+	URL            *string                 `json:"url,omitempty"`
+	HTMLURL        *string                 `json:"html_url,omitempty"`
+	PullRequests   []*github.PullRequest   `json:"pull_requests,omitempty"`
+	CreatedAt      *github.Timestamp       `json:"created_at,omitempty"`
+	UpdatedAt      *github.Timestamp       `json:"updated_at,omitempty"`
+	RunStartedAt   *github.Timestamp       `json:"run_started_at,omitempty"`
+	Actor          *github.User            `json:"actor,omitempty"`
+	TriggeringUser *github.User            `json:"triggering_actor,omitempty"`
+	HeadCommit     *github.PushEventCommit `json:"head_commit,omitempty"`
+	Repository     *github.Repository      `json:"repository,omitempty"`
+	HeadRepository *github.Repository      `json:"head_repository,omitempty"`
+}
+
+// WorkflowJobEvent is triggered when a GitHub Actions job is queued,
+// started or completed.
+// The Webhook event name is "workflow_job".
+type WorkflowJobEvent struct {
+	Action      *string      `json:"action,omitempty"`
+	WorkflowJob *WorkflowJob `json:"workflow_job,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// WorkflowJob represents a job of a GitHub Actions workflow run.
+type WorkflowJob struct {
+	ID              *int64            `json:"id,omitempty"`
+	RunID           *int64            `json:"run_id,omitempty"`
+	RunURL          *string           `json:"run_url,omitempty"`
+	RunAttempt      *int              `json:"run_attempt,omitempty"`
+	NodeID          *string           `json:"node_id,omitempty"`
+	HeadSHA         *string           `json:"head_sha,omitempty"`
+	HeadBranch      *string           `json:"head_branch,omitempty"`
+	URL             *string           `json:"url,omitempty"`
+	HTMLURL         *string           `json:"html_url,omitempty"`
+	Status          *string           `json:"status,omitempty"`
+	Conclusion      *string           `json:"conclusion,omitempty"`
+	CreatedAt       *github.Timestamp `json:"created_at,omitempty"`
+	StartedAt       *github.Timestamp `json:"started_at,omitempty"`
+	CompletedAt     *github.Timestamp `json:"completed_at,omitempty"`
+	Name            *string           `json:"name,omitempty"`
+	WorkflowName    *string           `json:"workflow_name,omitempty"`
+	Steps           []*WorkflowStep   `json:"steps,omitempty"`
+	Labels          []string          `json:"labels,omitempty"`
+	RunnerID        *int64            `json:"runner_id,omitempty"`
+	RunnerName      *string           `json:"runner_name,omitempty"`
+	RunnerGroupID   *int64            `json:"runner_group_id,omitempty"`
+	RunnerGroupName *string           `json:"runner_group_name,omitempty"`
+}
+
+// WorkflowStep represents a step of a GitHub Actions job.
+type WorkflowStep struct {
+	Name        *string           `json:"name,omitempty"`
+	Status      *string           `json:"status,omitempty"`
+	Conclusion  *string           `json:"conclusion,omitempty"`
+	Number      *int64            `json:"number,omitempty"`
+	StartedAt   *github.Timestamp `json:"started_at,omitempty"`
+	CompletedAt *github.Timestamp `json:"completed_at,omitempty"`
+}
+
+// WorkflowDispatchEvent is triggered when a workflow is run manually.
+// The Webhook event name is "workflow_dispatch".
+type WorkflowDispatchEvent struct {
+	Inputs   json.RawMessage `json:"inputs,omitempty"`
+	Ref      *string         `json:"ref,omitempty"`
+	Workflow *string         `json:"workflow,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// DiscussionEvent is triggered when a discussion is created, edited,
+// answered, labeled and so on.
+// The Webhook event name is "discussion".
+type DiscussionEvent struct {
+	Action     *string            `json:"action,omitempty"`
+	Discussion *Discussion        `json:"discussion,omitempty"`
+	Changes    *github.EditChange `json:"changes,omitempty"`
+	Label      *github.Label      `json:"label,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// Discussion represents a GitHub Discussion.
+type Discussion struct {
+	ID                *int64              `json:"id,omitempty"`
+	NodeID            *string             `json:"node_id,omitempty"`
+	Number            *int                `json:"number,omitempty"`
+	Title             *string             `json:"title,omitempty"`
+	Body              *string             `json:"body,omitempty"`
+	State             *string             `json:"state,omitempty"`
+	Locked            *bool               `json:"locked,omitempty"`
+	Comments          *int                `json:"comments,omitempty"`
+	HTMLURL           *string             `json:"html_url,omitempty"`
+	RepositoryURL     *string             `json:"repository_url,omitempty"`
+	AuthorAssociation *string             `json:"author_association,omitempty"`
+	User              *github.User        `json:"user,omitempty"`
+	Category          *DiscussionCategory `json:"category,omitempty"`
+	AnswerHTMLURL     *string             `json:"answer_html_url,omitempty"`
+	AnswerChosenAt    *github.Timestamp   `json:"answer_chosen_at,omitempty"`
+	AnswerChosenBy    *github.User        `json:"answer_chosen_by,omitempty"`
+	CreatedAt         *github.Timestamp   `json:"created_at,omitempty"`
+	UpdatedAt         *github.Timestamp   `json:"updated_at,omitempty"`
+}
+
+// DiscussionCategory represents the category of a discussion.
+type DiscussionCategory struct {
+	ID           *int64            `json:"id,omitempty"`
+	NodeID       *string           `json:"node_id,omitempty"`
+	RepositoryID *int64            `json:"repository_id,omitempty"`
+	Emoji        *string           `json:"emoji,omitempty"`
+	Name         *string           `json:"name,omitempty"`
+	Description  *string           `json:"description,omitempty"`
+	Slug         *string           `json:"slug,omitempty"`
+	IsAnswerable *bool             `json:"is_answerable,omitempty"`
+	CreatedAt    *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt    *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+// DiscussionCommentEvent is triggered when a comment on a discussion is
+// created, edited or deleted.
+// The Webhook event name is "discussion_comment".
+type DiscussionCommentEvent struct {
+	Action     *string            `json:"action,omitempty"`
+	Comment    *DiscussionComment `json:"comment,omitempty"`
+	Discussion *Discussion        `json:"discussion,omitempty"`
+	Changes    *github.EditChange `json:"changes,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// DiscussionComment represents a comment on a discussion.
+type DiscussionComment struct {
+	ID                *int64            `json:"id,omitempty"`
+	NodeID            *string           `json:"node_id,omitempty"`
+	HTMLURL           *string           `json:"html_url,omitempty"`
+	ParentID          *int64            `json:"parent_id,omitempty"`
+	ChildCommentCount *int              `json:"child_comment_count,omitempty"`
+	RepositoryURL     *string           `json:"repository_url,omitempty"`
+	DiscussionID      *int64            `json:"discussion_id,omitempty"`
+	AuthorAssociation *string           `json:"author_association,omitempty"`
+	User              *github.User      `json:"user,omitempty"`
+	Body              *string           `json:"body,omitempty"`
+	CreatedAt         *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt         *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+// MergeGroupEvent is triggered when a merge group is requested by the
+// merge queue or destroyed.
+// The Webhook event name is "merge_group".
+type MergeGroupEvent struct {
+	Action     *string     `json:"action,omitempty"`
+	Reason     *string     `json:"reason,omitempty"`
+	MergeGroup *MergeGroup `json:"merge_group,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// MergeGroup represents a group of pull requests tested together by the
+// merge queue.
+type MergeGroup struct {
+	HeadSHA    *string                 `json:"head_sha,omitempty"`
+	HeadRef    *string                 `json:"head_ref,omitempty"`
+	BaseSHA    *string                 `json:"base_sha,omitempty"`
+	BaseRef    *string                 `json:"base_ref,omitempty"`
+	HeadCommit *github.PushEventCommit `json:"head_commit,omitempty"`
+}
+
+// PackageEvent is triggered when a package is published or updated in
+// GitHub Packages.
+// The Webhook event name is "package".
+type PackageEvent struct {
+	Action  *string  `json:"action,omitempty"`
+	Package *Package `json:"package,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// RegistryPackageEvent is the legacy form of PackageEvent which is still
+// delivered for the GitHub Packages registry.
+// The Webhook event name is "registry_package".
+type RegistryPackageEvent struct {
+	Action          *string  `json:"action,omitempty"`
+	RegistryPackage *Package `json:"registry_package,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// Package represents a package in GitHub Packages.
+type Package struct {
+	ID             *int64            `json:"id,omitempty"`
+	Name           *string           `json:"name,omitempty"`
+	Namespace      *string           `json:"namespace,omitempty"`
+	Description    *string           `json:"description,omitempty"`
+	Ecosystem      *string           `json:"ecosystem,omitempty"`
+	PackageType    *string           `json:"package_type,omitempty"`
+	HTMLURL        *string           `json:"html_url,omitempty"`
+	CreatedAt      *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt      *github.Timestamp `json:"updated_at,omitempty"`
+	Owner          *github.User      `json:"owner,omitempty"`
+	PackageVersion *PackageVersion   `json:"package_version,omitempty"`
+	Registry       *PackageRegistry  `json:"registry,omitempty"`
+}
+
+// PackageVersion represents a version of a package.
+type PackageVersion struct {
+	ID                  *int64            `json:"id,omitempty"`
+	Version             *string           `json:"version,omitempty"`
+	Name                *string           `json:"name,omitempty"`
+	Summary             *string           `json:"summary,omitempty"`
+	Description         *string           `json:"description,omitempty"`
+	Body                *string           `json:"body,omitempty"`
+	HTMLURL             *string           `json:"html_url,omitempty"`
+	TargetCommitish     *string           `json:"target_commitish,omitempty"`
+	TagName             *string           `json:"tag_name,omitempty"`
+	Prerelease          *bool             `json:"prerelease,omitempty"`
+	Draft               *bool             `json:"draft,omitempty"`
+	InstallationCommand *string           `json:"installation_command,omitempty"`
+	Author              *github.User      `json:"author,omitempty"`
+	CreatedAt           *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt           *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+// PackageRegistry represents the registry a package is published to.
+type PackageRegistry struct {
+	AboutURL *string `json:"about_url,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Type     *string `json:"type,omitempty"`
+	URL      *string `json:"url,omitempty"`
+	Vendor   *string `json:"vendor,omitempty"`
+}
+
+// SecretScanningAlertEvent is triggered when a secret scanning alert is
+// created, resolved or reopened.
+// The Webhook event name is "secret_scanning_alert".
+type SecretScanningAlertEvent struct {
+	Action *string              `json:"action,omitempty"`
+	Alert  *SecretScanningAlert `json:"alert,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// SecretScanningAlert represents a secret scanning alert.
+type SecretScanningAlert struct {
+	Number                *int              `json:"number,omitempty"`
+	State                 *string           `json:"state,omitempty"`
+	SecretType            *string           `json:"secret_type,omitempty"`
+	SecretTypeDisplayName *string           `json:"secret_type_display_name,omitempty"`
+	Resolution            *string           `json:"resolution,omitempty"`
+	ResolvedBy            *github.User      `json:"resolved_by,omitempty"`
+	ResolvedAt            *github.Timestamp `json:"resolved_at,omitempty"`
+	URL                   *string           `json:"url,omitempty"`
+	HTMLURL               *string           `json:"html_url,omitempty"`
+	LocationsURL          *string           `json:"locations_url,omitempty"`
+	CreatedAt             *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt             *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+// CodeScanningAlertEvent is triggered when a code scanning alert is
+// created, fixed, dismissed or reopened.
+// The Webhook event name is "code_scanning_alert".
+type CodeScanningAlertEvent struct {
+	Action    *string            `json:"action,omitempty"`
+	Alert     *CodeScanningAlert `json:"alert,omitempty"`
+	Ref       *string            `json:"ref,omitempty"`
+	CommitOID *string            `json:"commit_oid,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// CodeScanningAlert represents a code scanning alert.
+type CodeScanningAlert struct {
+	Number             *int                       `json:"number,omitempty"`
+	State              *string                    `json:"state,omitempty"`
+	URL                *string                    `json:"url,omitempty"`
+	HTMLURL            *string                    `json:"html_url,omitempty"`
+	CreatedAt          *github.Timestamp          `json:"created_at,omitempty"`
+	UpdatedAt          *github.Timestamp          `json:"updated_at,omitempty"`
+	FixedAt            *github.Timestamp          `json:"fixed_at,omitempty"`
+	DismissedBy        *github.User               `json:"dismissed_by,omitempty"`
+	DismissedAt        *github.Timestamp          `json:"dismissed_at,omitempty"`
+	DismissedReason    *string                    `json:"dismissed_reason,omitempty"`
+	Rule               *CodeScanningRule          `json:"rule,omitempty"`
+	Tool               *CodeScanningTool          `json:"tool,omitempty"`
+	MostRecentInstance *CodeScanningAlertInstance `json:"most_recent_instance,omitempty"`
+}
+
+// CodeScanningRule represents the rule which raised a code scanning alert.
+type CodeScanningRule struct {
+	ID                    *string  `json:"id,omitempty"`
+	Name                  *string  `json:"name,omitempty"`
+	Severity              *string  `json:"severity,omitempty"`
+	SecuritySeverityLevel *string  `json:"security_severity_level,omitempty"`
+	Description           *string  `json:"description,omitempty"`
+	Tags                  []string `json:"tags,omitempty"`
+}
+
+// CodeScanningTool represents the analysis tool of a code scanning alert.
+type CodeScanningTool struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+	GUID    *string `json:"guid,omitempty"`
+}
+
+// CodeScanningAlertInstance represents where a code scanning alert was
+// found.
+type CodeScanningAlertInstance struct {
+	Ref             *string  `json:"ref,omitempty"`
+	AnalysisKey     *string  `json:"analysis_key,omitempty"`
+	Environment     *string  `json:"environment,omitempty"`
+	Category        *string  `json:"category,omitempty"`
+	State           *string  `json:"state,omitempty"`
+	CommitSHA       *string  `json:"commit_sha,omitempty"`
+	Classifications []string `json:"classifications,omitempty"`
+	Message         *struct {
+		Text *string `json:"text,omitempty"`
+	} `json:"message,omitempty"`
+	Location *struct {
+		Path        *string `json:"path,omitempty"`
+		StartLine   *int    `json:"start_line,omitempty"`
+		EndLine     *int    `json:"end_line,omitempty"`
+		StartColumn *int    `json:"start_column,omitempty"`
+		EndColumn   *int    `json:"end_column,omitempty"`
+	} `json:"location,omitempty"`
+}
+
+// BranchProtectionRuleEvent is triggered when a branch protection rule is
+// created, edited or deleted.
+// The Webhook event name is "branch_protection_rule".
+type BranchProtectionRuleEvent struct {
+	Action  *string               `json:"action,omitempty"`
+	Rule    *BranchProtectionRule `json:"rule,omitempty"`
+	Changes json.RawMessage       `json:"changes,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// BranchProtectionRule represents a branch protection rule as delivered
+// in webhook payloads.
+type BranchProtectionRule struct {
+	ID                                       *int64            `json:"id,omitempty"`
+	RepositoryID                             *int64            `json:"repository_id,omitempty"`
+	Name                                     *string           `json:"name,omitempty"`
+	CreatedAt                                *github.Timestamp `json:"created_at,omitempty"`
+	UpdatedAt                                *github.Timestamp `json:"updated_at,omitempty"`
+	PullRequestReviewsEnforcementLevel       *string           `json:"pull_request_reviews_enforcement_level,omitempty"`
+	RequiredApprovingReviewCount             *int              `json:"required_approving_review_count,omitempty"`
+	DismissStaleReviewsOnPush                *bool             `json:"dismiss_stale_reviews_on_push,omitempty"`
+	RequireCodeOwnerReview                   *bool             `json:"require_code_owner_review,omitempty"`
+	AuthorizedDismissalActorsOnly            *bool             `json:"authorized_dismissal_actors_only,omitempty"`
+	IgnoreApprovalsFromContributors          *bool             `json:"ignore_approvals_from_contributors,omitempty"`
+	RequiredStatusChecks                     []string          `json:"required_status_checks,omitempty"`
+	RequiredStatusChecksEnforcementLevel     *string           `json:"required_status_checks_enforcement_level,omitempty"`
+	StrictRequiredStatusChecksPolicy         *bool             `json:"strict_required_status_checks_policy,omitempty"`
+	SignatureRequirementEnforcementLevel     *string           `json:"signature_requirement_enforcement_level,omitempty"`
+	LinearHistoryRequirementEnforcementLevel *string           `json:"linear_history_requirement_enforcement_level,omitempty"`
+	AdminEnforced                            *bool             `json:"admin_enforced,omitempty"`
+	AllowForcePushesEnforcementLevel         *string           `json:"allow_force_pushes_enforcement_level,omitempty"`
+	AllowDeletionsEnforcementLevel           *string           `json:"allow_deletions_enforcement_level,omitempty"`
+	MergeQueueEnforcementLevel               *string           `json:"merge_queue_enforcement_level,omitempty"`
+	RequiredDeploymentsEnforcementLevel      *string           `json:"required_deployments_enforcement_level,omitempty"`
+	RequiredConversationResolutionLevel      *string           `json:"required_conversation_resolution_level,omitempty"`
+	AuthorizedActorsOnly                     *bool             `json:"authorized_actors_only,omitempty"`
+	AuthorizedActorNames                     []string          `json:"authorized_actor_names,omitempty"`
+}
+
+// RepositoryDispatchEvent is triggered by a repository_dispatch API call.
+// Action holds the event_type given by the caller.
+// The Webhook event name is "repository_dispatch".
+type RepositoryDispatchEvent struct {
+	Action        *string         `json:"action,omitempty"`
+	Branch        *string         `json:"branch,omitempty"`
+	ClientPayload json.RawMessage `json:"client_payload,omitempty"`
+
+	Repo         *github.Repository   `json:"repository,omitempty"`
+	Org          *github.Organization `json:"organization,omitempty"`
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// SponsorshipEvent is triggered when a sponsorship is created, cancelled
+// or changed.
+// The Webhook event name is "sponsorship".
+type SponsorshipEvent struct {
+	Action        *string         `json:"action,omitempty"`
+	EffectiveDate *string         `json:"effective_date,omitempty"`
+	Sponsorship   *Sponsorship    `json:"sponsorship,omitempty"`
+	Changes       json.RawMessage `json:"changes,omitempty"`
+
+	Sender       *github.User         `json:"sender,omitempty"`
+	Installation *github.Installation `json:"installation,omitempty"`
+}
+
+// Sponsorship represents a GitHub Sponsors sponsorship.
+type Sponsorship struct {
+	NodeID       *string           `json:"node_id,omitempty"`
+	CreatedAt    *github.Timestamp `json:"created_at,omitempty"`
+	PrivacyLevel *string           `json:"privacy_level,omitempty"`
+	Sponsorable  *github.User      `json:"sponsorable,omitempty"`
+	Sponsor      *github.User      `json:"sponsor,omitempty"`
+	Tier         *SponsorshipTier  `json:"tier,omitempty"`
+}
+
+// SponsorshipTier represents the tier of a sponsorship.
+type SponsorshipTier struct {
+	NodeID                *string           `json:"node_id,omitempty"`
+	CreatedAt             *github.Timestamp `json:"created_at,omitempty"`
+	Name                  *string           `json:"name,omitempty"`
+	Description           *string           `json:"description,omitempty"`
+	MonthlyPriceInCents   *int              `json:"monthly_price_in_cents,omitempty"`
+	MonthlyPriceInDollars *int              `json:"monthly_price_in_dollars,omitempty"`
+	IsOneTime             *bool             `json:"is_one_time,omitempty"`
+	IsCustomAmount        *bool             `json:"is_custom_ammount,omitempty"` // sic, as delivered by GitHub
+}
+
+// The accessors below are nil-safe in the same way as the ones generated
+// for go-github types.
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (w *WorkflowRunEvent) GetAction() string {
+	if w == nil || w.Action == nil {
+		return ""
+	}
+	return *w.Action
+}
+
+// GetRepo returns the Repo field.
+func (w *WorkflowRunEvent) GetRepo() *github.Repository {
+	if w == nil {
+		return nil
+	}
+	return w.Repo
+}
+
+// GetOrg returns the Org field.
+func (w *WorkflowRunEvent) GetOrg() *github.Organization {
+	if w == nil {
+		return nil
+	}
+	return w.Org
+}
+
+// GetSender returns the Sender field.
+func (w *WorkflowRunEvent) GetSender() *github.User {
+	if w == nil {
+		return nil
+	}
+	return w.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (w *WorkflowRunEvent) GetInstallation() *github.Installation {
+	if w == nil {
+		return nil
+	}
+	return w.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (w *WorkflowJobEvent) GetAction() string {
+	if w == nil || w.Action == nil {
+		return ""
+	}
+	return *w.Action
+}
+
+// GetRepo returns the Repo field.
+func (w *WorkflowJobEvent) GetRepo() *github.Repository {
+	if w == nil {
+		return nil
+	}
+	return w.Repo
+}
+
+// GetOrg returns the Org field.
+func (w *WorkflowJobEvent) GetOrg() *github.Organization {
+	if w == nil {
+		return nil
+	}
+	return w.Org
+}
+
+// GetSender returns the Sender field.
+func (w *WorkflowJobEvent) GetSender() *github.User {
+	if w == nil {
+		return nil
+	}
+	return w.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (w *WorkflowJobEvent) GetInstallation() *github.Installation {
+	if w == nil {
+		return nil
+	}
+	return w.Installation
+}
+
+// GetRepo returns the Repo field.
+func (w *WorkflowDispatchEvent) GetRepo() *github.Repository {
+	if w == nil {
+		return nil
+	}
+	return w.Repo
+}
+
+// GetOrg returns the Org field.
+func (w *WorkflowDispatchEvent) GetOrg() *github.Organization {
+	if w == nil {
+		return nil
+	}
+	return w.Org
+}
+
+// GetSender returns the Sender field.
+func (w *WorkflowDispatchEvent) GetSender() *github.User {
+	if w == nil {
+		return nil
+	}
+	return w.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (w *WorkflowDispatchEvent) GetInstallation() *github.Installation {
+	if w == nil {
+		return nil
+	}
+	return w.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DiscussionEvent) GetAction() string {
+	if d == nil || d.Action == nil {
+		return ""
+	}
+	return *d.Action
+}
+
+// GetRepo returns the Repo field.
+func (d *DiscussionEvent) GetRepo() *github.Repository {
+	if d == nil {
+		return nil
+	}
+	return d.Repo
+}
+
+// GetOrg returns the Org field.
+func (d *DiscussionEvent) GetOrg() *github.Organization {
+	if d == nil {
+		return nil
+	}
+	return d.Org
+}
+
+// GetSender returns the Sender field.
+func (d *DiscussionEvent) GetSender() *github.User {
+	if d == nil {
+		return nil
+	}
+	return d.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (d *DiscussionEvent) GetInstallation() *github.Installation {
+	if d == nil {
+		return nil
+	}
+	return d.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DiscussionCommentEvent) GetAction() string {
+	if d == nil || d.Action == nil {
+		return ""
+	}
+	return *d.Action
+}
+
+// GetRepo returns the Repo field.
+func (d *DiscussionCommentEvent) GetRepo() *github.Repository {
+	if d == nil {
+		return nil
+	}
+	return d.Repo
+}
+
+// GetOrg returns the Org field.
+func (d *DiscussionCommentEvent) GetOrg() *github.Organization {
+	if d == nil {
+		return nil
+	}
+	return d.Org
+}
+
+// GetSender returns the Sender field.
+func (d *DiscussionCommentEvent) GetSender() *github.User {
+	if d == nil {
+		return nil
+	}
+	return d.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (d *DiscussionCommentEvent) GetInstallation() *github.Installation {
+	if d == nil {
+		return nil
+	}
+	return d.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MergeGroupEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetRepo returns the Repo field.
+func (m *MergeGroupEvent) GetRepo() *github.Repository {
+	if m == nil {
+		return nil
+	}
+	return m.Repo
+}
+
+// GetOrg returns the Org field.
+func (m *MergeGroupEvent) GetOrg() *github.Organization {
+	if m == nil {
+		return nil
+	}
+	return m.Org
+}
+
+// GetSender returns the Sender field.
+func (m *MergeGroupEvent) GetSender() *github.User {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (m *MergeGroupEvent) GetInstallation() *github.Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PackageEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+// GetRepo returns the Repo field.
+func (p *PackageEvent) GetRepo() *github.Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repo
+}
+
+// GetOrg returns the Org field.
+func (p *PackageEvent) GetOrg() *github.Organization {
+	if p == nil {
+		return nil
+	}
+	return p.Org
+}
+
+// GetSender returns the Sender field.
+func (p *PackageEvent) GetSender() *github.User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (p *PackageEvent) GetInstallation() *github.Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RegistryPackageEvent) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
+	}
+	return *r.Action
+}
+
+// GetRepo returns the Repo field.
+func (r *RegistryPackageEvent) GetRepo() *github.Repository {
+	if r == nil {
+		return nil
+	}
+	return r.Repo
+}
+
+// GetOrg returns the Org field.
+func (r *RegistryPackageEvent) GetOrg() *github.Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Org
+}
+
+// GetSender returns the Sender field.
+func (r *RegistryPackageEvent) GetSender() *github.User {
+	if r == nil {
+		return nil
+	}
+	return r.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (r *RegistryPackageEvent) GetInstallation() *github.Installation {
+	if r == nil {
+		return nil
+	}
+	return r.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
+	}
+	return *s.Action
+}
+
+// GetRepo returns the Repo field.
+func (s *SecretScanningAlertEvent) GetRepo() *github.Repository {
+	if s == nil {
+		return nil
+	}
+	return s.Repo
+}
+
+// GetOrg returns the Org field.
+func (s *SecretScanningAlertEvent) GetOrg() *github.Organization {
+	if s == nil {
+		return nil
+	}
+	return s.Org
+}
+
+// GetSender returns the Sender field.
+func (s *SecretScanningAlertEvent) GetSender() *github.User {
+	if s == nil {
+		return nil
+	}
+	return s.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (s *SecretScanningAlertEvent) GetInstallation() *github.Installation {
+	if s == nil {
+		return nil
+	}
+	return s.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *CodeScanningAlertEvent) GetAction() string {
+	if c == nil || c.Action == nil {
+		return ""
+	}
+	return *c.Action
+}
+
+// GetRepo returns the Repo field.
+func (c *CodeScanningAlertEvent) GetRepo() *github.Repository {
+	if c == nil {
+		return nil
+	}
+	return c.Repo
+}
+
+// GetOrg returns the Org field.
+func (c *CodeScanningAlertEvent) GetOrg() *github.Organization {
+	if c == nil {
+		return nil
+	}
+	return c.Org
+}
+
+// GetSender returns the Sender field.
+func (c *CodeScanningAlertEvent) GetSender() *github.User {
+	if c == nil {
+		return nil
+	}
+	return c.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (c *CodeScanningAlertEvent) GetInstallation() *github.Installation {
+	if c == nil {
+		return nil
+	}
+	return c.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (b *BranchProtectionRuleEvent) GetAction() string {
+	if b == nil || b.Action == nil {
+		return ""
+	}
+	return *b.Action
+}
+
+// GetRepo returns the Repo field.
+func (b *BranchProtectionRuleEvent) GetRepo() *github.Repository {
+	if b == nil {
+		return nil
+	}
+	return b.Repo
+}
+
+// GetOrg returns the Org field.
+func (b *BranchProtectionRuleEvent) GetOrg() *github.Organization {
+	if b == nil {
+		return nil
+	}
+	return b.Org
+}
+
+// GetSender returns the Sender field.
+func (b *BranchProtectionRuleEvent) GetSender() *github.User {
+	if b == nil {
+		return nil
+	}
+	return b.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (b *BranchProtectionRuleEvent) GetInstallation() *github.Installation {
+	if b == nil {
+		return nil
+	}
+	return b.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryDispatchEvent) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
+	}
+	return *r.Action
+}
+
+// GetRepo returns the Repo field.
+func (r *RepositoryDispatchEvent) GetRepo() *github.Repository {
+	if r == nil {
+		return nil
+	}
+	return r.Repo
+}
+
+// GetOrg returns the Org field.
+func (r *RepositoryDispatchEvent) GetOrg() *github.Organization {
+	if r == nil {
+		return nil
+	}
+	return r.Org
+}
+
+// GetSender returns the Sender field.
+func (r *RepositoryDispatchEvent) GetSender() *github.User {
+	if r == nil {
+		return nil
+	}
+	return r.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (r *RepositoryDispatchEvent) GetInstallation() *github.Installation {
+	if r == nil {
+		return nil
+	}
+	return r.Installation
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SponsorshipEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
+	}
+	return *s.Action
+}
+
+// GetSender returns the Sender field.
+func (s *SponsorshipEvent) GetSender() *github.User {
+	if s == nil {
+		return nil
+	}
+	return s.Sender
+}
+
+// GetInstallation returns the Installation field.
+func (s *SponsorshipEvent) GetInstallation() *github.Installation {
+	if s == nil {
+		return nil
+	}
+	return s.Installation
+}
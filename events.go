@@ -0,0 +1,89 @@
+package ghbot
+
+import (
+	"encoding/json"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+var (
+	errUnknownEventType = xerrors.New("unknown event type")
+	errInvalidPayload   = xerrors.New("invalid payload")
+)
+
+// webHookEventTypes maps X-GitHub-Event types to the events they are
+// parsed into. It stands in for github.ParseWebHook, whose table in v25
+// lacks the types ghbot models itself as well as github_app_authorization,
+// although go-github has a type for it.
+var webHookEventTypes = map[string]func() interface{}{
+	"branch_protection_rule":         func() interface{} { return &BranchProtectionRuleEvent{} },
+	"check_run":                      func() interface{} { return &github.CheckRunEvent{} },
+	"check_suite":                    func() interface{} { return &github.CheckSuiteEvent{} },
+	"code_scanning_alert":            func() interface{} { return &CodeScanningAlertEvent{} },
+	"commit_comment":                 func() interface{} { return &github.CommitCommentEvent{} },
+	"create":                         func() interface{} { return &github.CreateEvent{} },
+	"delete":                         func() interface{} { return &github.DeleteEvent{} },
+	"deploy_key":                     func() interface{} { return &github.DeployKeyEvent{} },
+	"deployment":                     func() interface{} { return &github.DeploymentEvent{} },
+	"deployment_status":              func() interface{} { return &github.DeploymentStatusEvent{} },
+	"discussion":                     func() interface{} { return &DiscussionEvent{} },
+	"discussion_comment":             func() interface{} { return &DiscussionCommentEvent{} },
+	"fork":                           func() interface{} { return &github.ForkEvent{} },
+	"github_app_authorization":       func() interface{} { return &github.GitHubAppAuthorizationEvent{} },
+	"gollum":                         func() interface{} { return &github.GollumEvent{} },
+	"installation":                   func() interface{} { return &github.InstallationEvent{} },
+	"installation_repositories":      func() interface{} { return &github.InstallationRepositoriesEvent{} },
+	"issue_comment":                  func() interface{} { return &github.IssueCommentEvent{} },
+	"issues":                         func() interface{} { return &github.IssuesEvent{} },
+	"label":                          func() interface{} { return &github.LabelEvent{} },
+	"marketplace_purchase":           func() interface{} { return &github.MarketplacePurchaseEvent{} },
+	"member":                         func() interface{} { return &github.MemberEvent{} },
+	"membership":                     func() interface{} { return &github.MembershipEvent{} },
+	"merge_group":                    func() interface{} { return &MergeGroupEvent{} },
+	"meta":                           func() interface{} { return &github.MetaEvent{} },
+	"milestone":                      func() interface{} { return &github.MilestoneEvent{} },
+	"org_block":                      func() interface{} { return &github.OrgBlockEvent{} },
+	"organization":                   func() interface{} { return &github.OrganizationEvent{} },
+	"package":                        func() interface{} { return &PackageEvent{} },
+	"page_build":                     func() interface{} { return &github.PageBuildEvent{} },
+	"ping":                           func() interface{} { return &github.PingEvent{} },
+	"project":                        func() interface{} { return &github.ProjectEvent{} },
+	"project_card":                   func() interface{} { return &github.ProjectCardEvent{} },
+	"project_column":                 func() interface{} { return &github.ProjectColumnEvent{} },
+	"public":                         func() interface{} { return &github.PublicEvent{} },
+	"pull_request":                   func() interface{} { return &github.PullRequestEvent{} },
+	"pull_request_review":            func() interface{} { return &github.PullRequestReviewEvent{} },
+	"pull_request_review_comment":    func() interface{} { return &github.PullRequestReviewCommentEvent{} },
+	"push":                           func() interface{} { return &github.PushEvent{} },
+	"registry_package":               func() interface{} { return &RegistryPackageEvent{} },
+	"release":                        func() interface{} { return &github.ReleaseEvent{} },
+	"repository":                     func() interface{} { return &github.RepositoryEvent{} },
+	"repository_dispatch":            func() interface{} { return &RepositoryDispatchEvent{} },
+	"repository_vulnerability_alert": func() interface{} { return &github.RepositoryVulnerabilityAlertEvent{} },
+	"secret_scanning_alert":          func() interface{} { return &SecretScanningAlertEvent{} },
+	"sponsorship":                    func() interface{} { return &SponsorshipEvent{} },
+	"star":                           func() interface{} { return &github.StarEvent{} },
+	"status":                         func() interface{} { return &github.StatusEvent{} },
+	"team":                           func() interface{} { return &github.TeamEvent{} },
+	"team_add":                       func() interface{} { return &github.TeamAddEvent{} },
+	"watch":                          func() interface{} { return &github.WatchEvent{} },
+	"workflow_dispatch":              func() interface{} { return &WorkflowDispatchEvent{} },
+	"workflow_job":                   func() interface{} { return &WorkflowJobEvent{} },
+	"workflow_run":                   func() interface{} { return &WorkflowRunEvent{} },
+}
+
+// parseWebHook parses payload into the typed event for the given
+// X-GitHub-Event type. Types ghbot does not know are reported as
+// errUnknownEventType so that they can still be served by raw hooks.
+func parseWebHook(typ string, payload []byte) (interface{}, error) {
+	newEvent, ok := webHookEventTypes[typ]
+	if !ok {
+		return nil, xerrors.Errorf("%s: %w", typ, errUnknownEventType)
+	}
+	event := newEvent()
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, xerrors.Errorf("%s: %v: %w", typ, err, errInvalidPayload)
+	}
+	return event, nil
+}
@@ -0,0 +1,229 @@
+package ghbot_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+	"github.com/nasa9084/ghbot"
+	"github.com/nasa9084/ghbot/ghbottest"
+)
+
+type senderGetter interface {
+	GetSender() *github.User
+}
+
+// localEventPayloads are payloads of the event types go-github v25 does
+// not model, which ghbot parses into its own types.
+var localEventPayloads = []struct {
+	event   string
+	payload string
+	add     func(bot *ghbot.Bot, got func(interface{}))
+	check   func(t *testing.T, e interface{})
+}{
+	{
+		event:   "branch_protection_rule",
+		payload: `{"action":"created","rule":{"id":1,"name":"master"}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddBranchProtectionRuleEventHook(func(_ context.Context, e *ghbot.BranchProtectionRuleEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if name := *e.(*ghbot.BranchProtectionRuleEvent).Rule.Name; name != "master" {
+				t.Errorf("rule name = %q", name)
+			}
+		},
+	},
+	{
+		event:   "code_scanning_alert",
+		payload: `{"action":"created","alert":{"number":3,"state":"open"},"ref":"refs/heads/master"}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddCodeScanningAlertEventHook(func(_ context.Context, e *ghbot.CodeScanningAlertEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if n := *e.(*ghbot.CodeScanningAlertEvent).Alert.Number; n != 3 {
+				t.Errorf("alert number = %d", n)
+			}
+		},
+	},
+	{
+		event:   "discussion",
+		payload: `{"action":"created","discussion":{"number":4,"title":"Hello"}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddDiscussionEventHook(func(_ context.Context, e *ghbot.DiscussionEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if title := *e.(*ghbot.DiscussionEvent).Discussion.Title; title != "Hello" {
+				t.Errorf("discussion title = %q", title)
+			}
+		},
+	},
+	{
+		event:   "discussion_comment",
+		payload: `{"action":"created","comment":{"id":5},"discussion":{"number":4}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddDiscussionCommentEventHook(func(_ context.Context, e *ghbot.DiscussionCommentEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if id := *e.(*ghbot.DiscussionCommentEvent).Comment.ID; id != 5 {
+				t.Errorf("comment id = %d", id)
+			}
+		},
+	},
+	{
+		event:   "merge_group",
+		payload: `{"action":"checks_requested","merge_group":{"head_sha":"abc","base_ref":"refs/heads/master"}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddMergeGroupEventHook(func(_ context.Context, e *ghbot.MergeGroupEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if sha := *e.(*ghbot.MergeGroupEvent).MergeGroup.HeadSHA; sha != "abc" {
+				t.Errorf("head sha = %q", sha)
+			}
+		},
+	},
+	{
+		event:   "package",
+		payload: `{"action":"published","package":{"id":6,"name":"hello"}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddPackageEventHook(func(_ context.Context, e *ghbot.PackageEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if name := *e.(*ghbot.PackageEvent).Package.Name; name != "hello" {
+				t.Errorf("package name = %q", name)
+			}
+		},
+	},
+	{
+		event:   "registry_package",
+		payload: `{"action":"published","registry_package":{"id":7,"name":"hello"}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddRegistryPackageEventHook(func(_ context.Context, e *ghbot.RegistryPackageEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if id := *e.(*ghbot.RegistryPackageEvent).RegistryPackage.ID; id != 7 {
+				t.Errorf("package id = %d", id)
+			}
+		},
+	},
+	{
+		event:   "repository_dispatch",
+		payload: `{"action":"deploy","branch":"master","client_payload":{"env":"prod"}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddRepositoryDispatchEventHook(func(_ context.Context, e *ghbot.RepositoryDispatchEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if p := string(e.(*ghbot.RepositoryDispatchEvent).ClientPayload); p != `{"env":"prod"}` {
+				t.Errorf("client payload = %s", p)
+			}
+		},
+	},
+	{
+		event:   "secret_scanning_alert",
+		payload: `{"action":"created","alert":{"number":8,"secret_type":"github_token"}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddSecretScanningAlertEventHook(func(_ context.Context, e *ghbot.SecretScanningAlertEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if typ := *e.(*ghbot.SecretScanningAlertEvent).Alert.SecretType; typ != "github_token" {
+				t.Errorf("secret type = %q", typ)
+			}
+		},
+	},
+	{
+		event:   "sponsorship",
+		payload: `{"action":"created","sponsorship":{"privacy_level":"public","sponsorable":{"login":"octo-org"}}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddSponsorshipEventHook(func(_ context.Context, e *ghbot.SponsorshipEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if level := *e.(*ghbot.SponsorshipEvent).Sponsorship.PrivacyLevel; level != "public" {
+				t.Errorf("privacy level = %q", level)
+			}
+		},
+	},
+	{
+		event:   "workflow_dispatch",
+		payload: `{"ref":"refs/heads/master","workflow":".github/workflows/ci.yml","inputs":{"debug":"true"}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddWorkflowDispatchEventHook(func(_ context.Context, e *ghbot.WorkflowDispatchEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if wf := *e.(*ghbot.WorkflowDispatchEvent).Workflow; wf != ".github/workflows/ci.yml" {
+				t.Errorf("workflow = %q", wf)
+			}
+		},
+	},
+	{
+		event:   "workflow_job",
+		payload: `{"action":"queued","workflow_job":{"id":9,"run_id":10,"labels":["ubuntu-latest"]}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddWorkflowJobEventHook(func(_ context.Context, e *ghbot.WorkflowJobEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if labels := e.(*ghbot.WorkflowJobEvent).WorkflowJob.Labels; len(labels) != 1 || labels[0] != "ubuntu-latest" {
+				t.Errorf("labels = %v", labels)
+			}
+		},
+	},
+	{
+		event:   "workflow_run",
+		payload: `{"action":"completed","workflow":{"id":11,"name":"CI"},"workflow_run":{"id":12,"conclusion":"success"}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddWorkflowRunEventHook(func(_ context.Context, e *ghbot.WorkflowRunEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if c := *e.(*ghbot.WorkflowRunEvent).WorkflowRun.Conclusion; c != "success" {
+				t.Errorf("conclusion = %q", c)
+			}
+		},
+	},
+	{
+		// go-github models the requested actions of check runs
+		event:   "check_run",
+		payload: `{"action":"requested_action","check_run":{"id":13},"requested_action":{"identifier":"fix"}}`,
+		add: func(bot *ghbot.Bot, got func(interface{})) {
+			bot.AddCheckRunEventHook(func(_ context.Context, e *github.CheckRunEvent) error { got(e); return nil })
+		},
+		check: func(t *testing.T, e interface{}) {
+			if id := e.(*github.CheckRunEvent).GetRequestedAction().Identifier; id != "fix" {
+				t.Errorf("requested action = %q", id)
+			}
+		},
+	},
+}
+
+func TestLocalEventTypes(t *testing.T) {
+	for _, tc := range localEventPayloads {
+		tc := tc
+		t.Run(tc.event, func(t *testing.T) {
+			// every payload is sent by the same user, and from the
+			// repository where it applies
+			var payload map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(tc.payload), &payload); err != nil {
+				t.Fatal(err)
+			}
+			payload["sender"] = json.RawMessage(`{"login":"octocat","type":"User"}`)
+			payload["repository"] = json.RawMessage(`{"id":1,"name":"hello-world","full_name":"octocat/hello-world"}`)
+
+			bot := ghbot.New(ghbot.Config{WebHookSecret: "secret"})
+			var got interface{}
+			tc.add(bot, func(e interface{}) { got = e })
+			result, err := ghbottest.New(bot, "secret").SendEvent(tc.event, payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d", result.StatusCode)
+			}
+			if got == nil {
+				t.Fatalf("%s hook did not run", tc.event)
+			}
+			if login := got.(senderGetter).GetSender().GetLogin(); login != "octocat" {
+				t.Errorf("sender = %q", login)
+			}
+			tc.check(t, got)
+		})
+	}
+}
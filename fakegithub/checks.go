@@ -0,0 +1,240 @@
+package fakegithub
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v25/github"
+)
+
+// resolveRef returns the SHA of ref when it is the head or base branch of
+// a pull request, or ref itself.
+func (repo *repository) resolveRef(ref string) string {
+	for _, iss := range repo.issues {
+		if iss.pr == nil {
+			continue
+		}
+		if iss.pr.GetHead().GetRef() == ref {
+			return iss.pr.GetHead().GetSHA()
+		}
+	}
+	return ref
+}
+
+func (repo *repository) statusesOf(ref string) []*github.RepoStatus {
+	statuses := repo.statuses[repo.resolveRef(ref)]
+	if statuses == nil {
+		statuses = []*github.RepoStatus{}
+	}
+	return statuses
+}
+
+func (repo *repository) checkRunsOf(ref string) []*github.CheckRun {
+	sha := repo.resolveRef(ref)
+	runs := []*github.CheckRun{}
+	for _, run := range repo.checkRuns {
+		if run.GetHeadSHA() == sha {
+			runs = append(runs, run)
+		}
+	}
+	return runs
+}
+
+func (s *Server) createStatus(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	var req github.RepoStatus
+	if !decode(r, &req) {
+		return invalid("invalid request")
+	}
+	switch req.GetState() {
+	case "error", "failure", "pending", "success":
+	default:
+		return invalid("state must be one of error, failure, pending or success")
+	}
+	context := req.GetContext()
+	if context == "" {
+		context = "default"
+	}
+	status := &github.RepoStatus{
+		ID:          github.Int64(s.nextID()),
+		State:       req.State,
+		TargetURL:   req.TargetURL,
+		Description: req.Description,
+		Context:     github.String(context),
+		Creator:     s.user(s.Login),
+		CreatedAt:   now(),
+		UpdatedAt:   now(),
+	}
+	sha := p["sha"]
+	// newest first, as GitHub lists them
+	repo.statuses[sha] = append([]*github.RepoStatus{status}, repo.statuses[sha]...)
+	return http.StatusCreated, status
+}
+
+func (s *Server) listStatuses(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	return http.StatusOK, repo.statusesOf(p["ref"])
+}
+
+func (s *Server) combinedStatus(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	// only the newest status of every context counts
+	seen := map[string]bool{}
+	var latest []github.RepoStatus
+	for _, status := range repo.statusesOf(p["ref"]) {
+		if !seen[status.GetContext()] {
+			seen[status.GetContext()] = true
+			latest = append(latest, *status)
+		}
+	}
+	state := "success"
+	for _, status := range latest {
+		switch status.GetState() {
+		case "error", "failure":
+			state = "failure"
+		case "pending":
+			if state == "success" {
+				state = "pending"
+			}
+		}
+	}
+	if len(latest) == 0 {
+		state = "pending"
+	}
+	return http.StatusOK, &github.CombinedStatus{
+		State:      github.String(state),
+		SHA:        github.String(repo.resolveRef(p["ref"])),
+		TotalCount: github.Int(len(latest)),
+		Statuses:   latest,
+	}
+}
+
+func (s *Server) createCheckRun(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	var req github.CreateCheckRunOptions
+	if !decode(r, &req) || req.Name == "" || req.HeadSHA == "" {
+		return invalid("name and head_sha are required")
+	}
+	status := req.GetStatus()
+	if status == "" {
+		status = "queued"
+	}
+	run := &github.CheckRun{
+		ID:          github.Int64(s.nextID()),
+		Name:        github.String(req.Name),
+		HeadSHA:     github.String(req.HeadSHA),
+		ExternalID:  req.ExternalID,
+		DetailsURL:  req.DetailsURL,
+		Status:      github.String(status),
+		Conclusion:  req.Conclusion,
+		StartedAt:   req.StartedAt,
+		CompletedAt: req.CompletedAt,
+		Output:      req.Output,
+	}
+	if run.StartedAt == nil {
+		run.StartedAt = &github.Timestamp{Time: *now()}
+	}
+	repo.checkRuns = append(repo.checkRuns, run)
+	return http.StatusCreated, run
+}
+
+func (repo *repository) lookupCheckRun(p params) *github.CheckRun {
+	id, err := strconv.ParseInt(p["id"], 10, 64)
+	if err != nil {
+		return nil
+	}
+	for _, run := range repo.checkRuns {
+		if run.GetID() == id {
+			return run
+		}
+	}
+	return nil
+}
+
+func (s *Server) getCheckRun(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	run := repo.lookupCheckRun(p)
+	if run == nil {
+		return notFound()
+	}
+	return http.StatusOK, run
+}
+
+func (s *Server) updateCheckRun(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	run := repo.lookupCheckRun(p)
+	if run == nil {
+		return notFound()
+	}
+	var req github.UpdateCheckRunOptions
+	if !decode(r, &req) {
+		return invalid("invalid request")
+	}
+	if req.Name != "" {
+		run.Name = github.String(req.Name)
+	}
+	if req.HeadSHA != nil {
+		run.HeadSHA = req.HeadSHA
+	}
+	if req.DetailsURL != nil {
+		run.DetailsURL = req.DetailsURL
+	}
+	if req.ExternalID != nil {
+		run.ExternalID = req.ExternalID
+	}
+	if req.Status != nil {
+		run.Status = req.Status
+	}
+	if req.Conclusion != nil {
+		run.Conclusion = req.Conclusion
+		run.Status = github.String("completed")
+	}
+	if req.CompletedAt != nil {
+		run.CompletedAt = req.CompletedAt
+	} else if run.GetStatus() == "completed" && run.CompletedAt == nil {
+		run.CompletedAt = &github.Timestamp{Time: *now()}
+	}
+	if req.Output != nil {
+		run.Output = req.Output
+	}
+	return http.StatusOK, run
+}
+
+func (s *Server) listCheckRuns(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	runs := repo.checkRunsOf(p["ref"])
+	if name := r.URL.Query().Get("check_name"); name != "" {
+		filtered := []*github.CheckRun{}
+		for _, run := range runs {
+			if run.GetName() == name {
+				filtered = append(filtered, run)
+			}
+		}
+		runs = filtered
+	}
+	return http.StatusOK, &github.ListCheckRunsResults{
+		Total:     github.Int(len(runs)),
+		CheckRuns: runs,
+	}
+}
@@ -0,0 +1,110 @@
+package fakegithub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v25/github"
+)
+
+// PollInterval is sent as X-Poll-Interval by the Events API endpoints.
+const PollInterval = 60
+
+// AddEvent adds an event of the Events API type, e.g. "IssueCommentEvent",
+// to the repository, which is created if needed, and returns its ID. The
+// payload is marshaled as JSON and, as on GitHub, carries neither the
+// repository nor the sender, which are put beside it.
+func (s *Server) AddEvent(fullName, typ string, payload interface{}) string {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		panic(err)
+	}
+	raw := json.RawMessage(b)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.addRepo(fullName)
+	owner, _ := splitFullName(fullName)
+	e := &github.Event{
+		ID:   github.String(strconv.FormatInt(s.nextID(), 10)),
+		Type: github.String(typ),
+		Repo: &github.Repository{
+			ID:   repo.repo.ID,
+			Name: github.String(fullName),
+			URL:  github.String(s.URL + "repos/" + fullName),
+		},
+		Actor:      s.user(s.Login),
+		Org:        &github.Organization{Login: github.String(owner)},
+		RawPayload: &raw,
+		Public:     github.Bool(true),
+		CreatedAt:  now(),
+	}
+	s.events = append(s.events, e)
+	return e.GetID()
+}
+
+func (s *Server) listRepoEvents(r *http.Request, p params) (int, interface{}) {
+	if s.lookupRepo(p) == nil {
+		return notFound()
+	}
+	fullName := p["owner"] + "/" + p["repo"]
+	return s.listEvents(r, func(e *github.Event) bool {
+		return strings.EqualFold(e.GetRepo().GetName(), fullName)
+	})
+}
+
+func (s *Server) listOrgEvents(r *http.Request, p params) (int, interface{}) {
+	return s.listEvents(r, func(e *github.Event) bool {
+		return strings.EqualFold(e.GetOrg().GetLogin(), p["org"])
+	})
+}
+
+// listEvents answers like the Events API: newest first, paginated, with
+// an ETag to poll with and X-Poll-Interval.
+func (s *Server) listEvents(r *http.Request, match func(*github.Event) bool) (int, interface{}) {
+	events := []*github.Event{}
+	for i := len(s.events) - 1; i >= 0; i-- {
+		if match(s.events[i]) {
+			events = append(events, s.events[i])
+		}
+	}
+	etag := `"0"`
+	if len(events) > 0 {
+		etag = fmt.Sprintf(`"%s"`, events[0].GetID())
+	}
+	header := http.Header{
+		"Etag":            {etag},
+		"X-Poll-Interval": {strconv.Itoa(PollInterval)},
+	}
+	if r.Header.Get("If-None-Match") == etag {
+		return http.StatusNotModified, withHeader{header: header}
+	}
+
+	q := r.URL.Query()
+	perPage, _ := strconv.Atoi(q.Get("per_page"))
+	if perPage <= 0 {
+		perPage = 30
+	}
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page <= 0 {
+		page = 1
+	}
+	start := (page - 1) * perPage
+	if start > len(events) {
+		start = len(events)
+	}
+	end := start + perPage
+	if end < len(events) {
+		next := *r.URL
+		q.Set("page", strconv.Itoa(page+1))
+		next.RawQuery = q.Encode()
+		header.Set("Link", fmt.Sprintf(`<%s%s>; rel="next"`, strings.TrimSuffix(s.URL, "/"), next.RequestURI()))
+	} else {
+		end = len(events)
+	}
+	return http.StatusOK, withHeader{header: header, body: events[start:end]}
+}
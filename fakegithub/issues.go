@@ -0,0 +1,410 @@
+package fakegithub
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v25/github"
+)
+
+func (repo *repository) commentsOf(iss *issue) []*github.IssueComment {
+	comments := make([]*github.IssueComment, 0, len(iss.comments))
+	for _, id := range iss.comments {
+		if c, ok := repo.issueComments[id]; ok {
+			comments = append(comments, c)
+		}
+	}
+	return comments
+}
+
+func (repo *repository) lookupComment(p params) *github.IssueComment {
+	id, err := strconv.ParseInt(p["id"], 10, 64)
+	if err != nil {
+		return nil
+	}
+	return repo.issueComments[id]
+}
+
+func (s *Server) listIssues(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	state := r.URL.Query().Get("state")
+	if state == "" {
+		state = "open"
+	}
+	issues := []*github.Issue{}
+	for _, iss := range repo.issues {
+		if state == "all" || iss.issue.GetState() == state {
+			issues = append(issues, iss.issue)
+		}
+	}
+	sort.Slice(issues, func(i, j int) bool { return issues[i].GetNumber() > issues[j].GetNumber() })
+	return http.StatusOK, issues
+}
+
+func (s *Server) createIssue(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	var req github.IssueRequest
+	if !decode(r, &req) || req.GetTitle() == "" {
+		return invalid("title is required")
+	}
+	iss := repo.newIssue(s, req.GetTitle(), req.GetBody(), s.Login)
+	s.applyIssueRequest(repo, iss, &req)
+	return http.StatusCreated, iss.issue
+}
+
+func (s *Server) getIssue(_ *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupIssue(p)
+	if iss == nil {
+		return notFound()
+	}
+	return http.StatusOK, iss.issue
+}
+
+func (s *Server) editIssue(r *http.Request, p params) (int, interface{}) {
+	repo, iss := s.lookupIssue(p)
+	if iss == nil {
+		return notFound()
+	}
+	var req github.IssueRequest
+	if !decode(r, &req) {
+		return invalid("invalid request")
+	}
+	s.applyIssueRequest(repo, iss, &req)
+	return http.StatusOK, iss.issue
+}
+
+func (s *Server) applyIssueRequest(repo *repository, iss *issue, req *github.IssueRequest) {
+	if req.Title != nil {
+		iss.issue.Title = req.Title
+	}
+	if req.Body != nil {
+		iss.issue.Body = req.Body
+	}
+	if req.State != nil {
+		iss.setState(req.GetState())
+	}
+	if req.Labels != nil {
+		iss.issue.Labels = nil
+		s.addLabels(repo, iss, *req.Labels)
+	}
+	if req.Assignees != nil {
+		iss.issue.Assignees = nil
+		for _, login := range *req.Assignees {
+			iss.issue.Assignees = append(iss.issue.Assignees, s.user(login))
+		}
+	}
+	iss.issue.UpdatedAt = now()
+}
+
+func (iss *issue) setState(state string) {
+	iss.issue.State = github.String(state)
+	if state == "closed" {
+		iss.issue.ClosedAt = now()
+	} else {
+		iss.issue.ClosedAt = nil
+	}
+}
+
+func (s *Server) listIssueComments(_ *http.Request, p params) (int, interface{}) {
+	repo, iss := s.lookupIssue(p)
+	if iss == nil {
+		return notFound()
+	}
+	return http.StatusOK, repo.commentsOf(iss)
+}
+
+func (s *Server) createIssueComment(r *http.Request, p params) (int, interface{}) {
+	repo, iss := s.lookupIssue(p)
+	if iss == nil {
+		return notFound()
+	}
+	var req github.IssueComment
+	if !decode(r, &req) || req.GetBody() == "" {
+		return invalid("body is required")
+	}
+	id := s.nextID()
+	c := &github.IssueComment{
+		ID:        github.Int64(id),
+		Body:      req.Body,
+		User:      s.user(s.Login),
+		HTMLURL:   github.String(iss.issue.GetHTMLURL() + "#issuecomment-" + strconv.FormatInt(id, 10)),
+		IssueURL:  iss.issue.HTMLURL,
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+	repo.issueComments[id] = c
+	iss.comments = append(iss.comments, id)
+	iss.issue.Comments = github.Int(len(iss.comments))
+	return http.StatusCreated, c
+}
+
+func (s *Server) getIssueComment(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	c := repo.lookupComment(p)
+	if c == nil {
+		return notFound()
+	}
+	return http.StatusOK, c
+}
+
+func (s *Server) editIssueComment(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	c := repo.lookupComment(p)
+	if c == nil {
+		return notFound()
+	}
+	var req github.IssueComment
+	if !decode(r, &req) || req.GetBody() == "" {
+		return invalid("body is required")
+	}
+	c.Body = req.Body
+	c.UpdatedAt = now()
+	return http.StatusOK, c
+}
+
+func (s *Server) deleteIssueComment(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	c := repo.lookupComment(p)
+	if c == nil {
+		return notFound()
+	}
+	delete(repo.issueComments, c.GetID())
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) listReactions(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	id, _ := strconv.ParseInt(p["id"], 10, 64)
+	reactions := repo.reactions[id]
+	if reactions == nil {
+		reactions = []*github.Reaction{}
+	}
+	return http.StatusOK, reactions
+}
+
+func (s *Server) createReaction(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	// comments of simulated deliveries are unknown to the server, so
+	// reactions to any comment are accepted
+	id, _ := strconv.ParseInt(p["id"], 10, 64)
+	var req github.Reaction
+	if !decode(r, &req) || req.GetContent() == "" {
+		return invalid("content is required")
+	}
+	for _, reaction := range repo.reactions[id] {
+		if reaction.GetContent() == req.GetContent() && reaction.GetUser().GetLogin() == s.Login {
+			return http.StatusOK, reaction
+		}
+	}
+	reaction := &github.Reaction{
+		ID:      github.Int64(s.nextID()),
+		User:    s.user(s.Login),
+		Content: req.Content,
+	}
+	repo.reactions[id] = append(repo.reactions[id], reaction)
+	return http.StatusCreated, reaction
+}
+
+func (s *Server) addLabels(repo *repository, iss *issue, names []string) {
+	for _, name := range names {
+		found := false
+		for _, l := range iss.issue.Labels {
+			if strings.EqualFold(l.GetName(), name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			iss.issue.Labels = append(iss.issue.Labels, *repo.label(s, name))
+		}
+	}
+}
+
+func (s *Server) listIssueLabels(_ *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupIssue(p)
+	if iss == nil {
+		return notFound()
+	}
+	labels := iss.issue.Labels
+	if labels == nil {
+		labels = []github.Label{}
+	}
+	return http.StatusOK, labels
+}
+
+func (s *Server) addIssueLabels(r *http.Request, p params) (int, interface{}) {
+	repo, iss := s.lookupIssue(p)
+	if iss == nil {
+		return notFound()
+	}
+	var names []string
+	if !decode(r, &names) {
+		return invalid("labels must be a list of names")
+	}
+	s.addLabels(repo, iss, names)
+	return http.StatusOK, iss.issue.Labels
+}
+
+func (s *Server) replaceIssueLabels(r *http.Request, p params) (int, interface{}) {
+	repo, iss := s.lookupIssue(p)
+	if iss == nil {
+		return notFound()
+	}
+	var names []string
+	if !decode(r, &names) {
+		return invalid("labels must be a list of names")
+	}
+	iss.issue.Labels = nil
+	s.addLabels(repo, iss, names)
+	return http.StatusOK, iss.issue.Labels
+}
+
+func (s *Server) removeIssueLabels(_ *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupIssue(p)
+	if iss == nil {
+		return notFound()
+	}
+	iss.issue.Labels = nil
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) removeIssueLabel(_ *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupIssue(p)
+	if iss == nil {
+		return notFound()
+	}
+	for i, l := range iss.issue.Labels {
+		if strings.EqualFold(l.GetName(), p["name"]) {
+			iss.issue.Labels = append(iss.issue.Labels[:i:i], iss.issue.Labels[i+1:]...)
+			return http.StatusOK, iss.issue.Labels
+		}
+	}
+	return http.StatusNotFound, errorResponse{Message: "Label does not exist"}
+}
+
+func (s *Server) listLabels(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	labels := []*github.Label{}
+	for _, l := range repo.labels {
+		labels = append(labels, l)
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].GetName() < labels[j].GetName() })
+	return http.StatusOK, labels
+}
+
+func (s *Server) createLabel(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	var req github.Label
+	if !decode(r, &req) || req.GetName() == "" {
+		return invalid("name is required")
+	}
+	if _, ok := repo.labels[strings.ToLower(req.GetName())]; ok {
+		return invalid("label already exists")
+	}
+	l := repo.label(s, req.GetName())
+	if req.Color != nil {
+		l.Color = req.Color
+	}
+	l.Description = req.Description
+	return http.StatusCreated, l
+}
+
+func (s *Server) getLabel(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	l, ok := repo.labels[strings.ToLower(p["name"])]
+	if !ok {
+		return notFound()
+	}
+	return http.StatusOK, l
+}
+
+func (s *Server) editLabel(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	key := strings.ToLower(p["name"])
+	l, ok := repo.labels[key]
+	if !ok {
+		return notFound()
+	}
+	var req github.Label
+	if !decode(r, &req) {
+		return invalid("invalid request")
+	}
+	if req.Color != nil {
+		l.Color = req.Color
+	}
+	if req.Description != nil {
+		l.Description = req.Description
+	}
+	if req.Name != nil && req.GetName() != l.GetName() {
+		delete(repo.labels, key)
+		l.Name = req.Name
+		repo.labels[strings.ToLower(req.GetName())] = l
+	}
+	// issues hold copies of their labels
+	for _, iss := range repo.issues {
+		for i := range iss.issue.Labels {
+			if iss.issue.Labels[i].GetID() == l.GetID() {
+				iss.issue.Labels[i] = *l
+			}
+		}
+	}
+	return http.StatusOK, l
+}
+
+func (s *Server) deleteLabel(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	key := strings.ToLower(p["name"])
+	l, ok := repo.labels[key]
+	if !ok {
+		return notFound()
+	}
+	delete(repo.labels, key)
+	for _, iss := range repo.issues {
+		labels := iss.issue.Labels[:0]
+		for _, il := range iss.issue.Labels {
+			if il.GetID() != l.GetID() {
+				labels = append(labels, il)
+			}
+		}
+		iss.issue.Labels = labels
+	}
+	return http.StatusNoContent, nil
+}
@@ -0,0 +1,325 @@
+package fakegithub
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v25/github"
+)
+
+// pullRequest returns the pull request with the fields it shares with its
+// issue filled in.
+func (iss *issue) pullRequest() *github.PullRequest {
+	pr := iss.pr
+	pr.Title = iss.issue.Title
+	pr.Body = iss.issue.Body
+	pr.State = iss.issue.State
+	pr.User = iss.issue.User
+	pr.Assignees = iss.issue.Assignees
+	pr.CreatedAt = iss.issue.CreatedAt
+	pr.UpdatedAt = iss.issue.UpdatedAt
+	pr.ClosedAt = iss.issue.ClosedAt
+	pr.Comments = iss.issue.Comments
+	pr.Labels = nil
+	for i := range iss.issue.Labels {
+		l := iss.issue.Labels[i]
+		pr.Labels = append(pr.Labels, &l)
+	}
+	return pr
+}
+
+func (s *Server) lookupPullRequest(p params) (*repository, *issue) {
+	repo, iss := s.lookupIssue(p)
+	if iss == nil || iss.pr == nil {
+		return repo, nil
+	}
+	return repo, iss
+}
+
+func (repo *repository) reviewCommentsOf(iss *issue) []*github.PullRequestComment {
+	comments := make([]*github.PullRequestComment, 0, len(iss.reviewComments))
+	for _, id := range iss.reviewComments {
+		if c, ok := repo.reviewComment[id]; ok {
+			comments = append(comments, c)
+		}
+	}
+	return comments
+}
+
+func (s *Server) listPullRequests(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	q := r.URL.Query()
+	state := q.Get("state")
+	if state == "" {
+		state = "open"
+	}
+	prs := []*github.PullRequest{}
+	for _, iss := range repo.issues {
+		if iss.pr == nil || (state != "all" && iss.issue.GetState() != state) {
+			continue
+		}
+		if base := q.Get("base"); base != "" && iss.pr.GetBase().GetRef() != base {
+			continue
+		}
+		if head := q.Get("head"); head != "" && iss.pr.GetHead().GetRef() != head[strings.Index(head, ":")+1:] {
+			continue
+		}
+		prs = append(prs, iss.pullRequest())
+	}
+	sort.Slice(prs, func(i, j int) bool { return prs[i].GetNumber() > prs[j].GetNumber() })
+	return http.StatusOK, prs
+}
+
+func (s *Server) createPullRequest(r *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	var req github.NewPullRequest
+	if !decode(r, &req) || req.GetTitle() == "" || req.GetHead() == "" || req.GetBase() == "" {
+		return invalid("title, head and base are required")
+	}
+	iss := repo.newPullRequest(s, req.GetTitle(), req.GetBody(), s.Login, req.GetHead(), req.GetBase())
+	iss.pr.Draft = req.Draft
+	return http.StatusCreated, iss.pullRequest()
+}
+
+func (s *Server) getPullRequest(_ *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupPullRequest(p)
+	if iss == nil {
+		return notFound()
+	}
+	return http.StatusOK, iss.pullRequest()
+}
+
+func (s *Server) editPullRequest(r *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupPullRequest(p)
+	if iss == nil {
+		return notFound()
+	}
+	var req struct {
+		Title *string `json:"title"`
+		Body  *string `json:"body"`
+		State *string `json:"state"`
+		Base  *string `json:"base"`
+	}
+	if !decode(r, &req) {
+		return invalid("invalid request")
+	}
+	if req.Title != nil {
+		iss.issue.Title = req.Title
+	}
+	if req.Body != nil {
+		iss.issue.Body = req.Body
+	}
+	if req.State != nil {
+		if iss.pr.GetMerged() {
+			return invalid("cannot change the state of a merged pull request")
+		}
+		iss.setState(*req.State)
+	}
+	if req.Base != nil {
+		iss.pr.Base.Ref = req.Base
+	}
+	iss.issue.UpdatedAt = now()
+	return http.StatusOK, iss.pullRequest()
+}
+
+func (s *Server) listPullRequestFiles(_ *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupPullRequest(p)
+	if iss == nil {
+		return notFound()
+	}
+	files := iss.files
+	if files == nil {
+		files = []*github.CommitFile{}
+	}
+	return http.StatusOK, files
+}
+
+func (s *Server) isMerged(_ *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupPullRequest(p)
+	if iss == nil || !iss.pr.GetMerged() {
+		return http.StatusNotFound, nil
+	}
+	return http.StatusNoContent, nil
+}
+
+func (s *Server) merge(r *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupPullRequest(p)
+	if iss == nil {
+		return notFound()
+	}
+	var req struct {
+		SHA string `json:"sha"`
+	}
+	decode(r, &req)
+	switch {
+	case iss.pr.GetMerged():
+		return http.StatusMethodNotAllowed, errorResponse{Message: "Pull Request is not mergeable"}
+	case iss.issue.GetState() != "open":
+		return http.StatusMethodNotAllowed, errorResponse{Message: "Pull Request is not mergeable"}
+	case req.SHA != "" && req.SHA != iss.pr.GetHead().GetSHA():
+		return http.StatusConflict, errorResponse{Message: "Head branch was modified. Review and try the merge again."}
+	}
+	sha := fmt.Sprintf("%040x", s.nextID())
+	iss.pr.Merged = github.Bool(true)
+	iss.pr.MergedAt = now()
+	iss.pr.MergeCommitSHA = github.String(sha)
+	iss.setState("closed")
+	return http.StatusOK, &github.PullRequestMergeResult{
+		SHA:     github.String(sha),
+		Merged:  github.Bool(true),
+		Message: github.String("Pull Request successfully merged"),
+	}
+}
+
+func (s *Server) listReviewComments(_ *http.Request, p params) (int, interface{}) {
+	repo, iss := s.lookupPullRequest(p)
+	if iss == nil {
+		return notFound()
+	}
+	return http.StatusOK, repo.reviewCommentsOf(iss)
+}
+
+func (s *Server) createReviewComment(r *http.Request, p params) (int, interface{}) {
+	repo, iss := s.lookupPullRequest(p)
+	if iss == nil {
+		return notFound()
+	}
+	var req github.PullRequestComment
+	if !decode(r, &req) || req.GetBody() == "" {
+		return invalid("body is required")
+	}
+	c := s.newReviewComment(iss, &req)
+	if req.InReplyTo != nil {
+		parent, ok := repo.reviewComment[req.GetInReplyTo()]
+		if !ok {
+			return notFound()
+		}
+		c.Path, c.Position, c.CommitID = parent.Path, parent.Position, parent.CommitID
+	} else if req.GetPath() == "" {
+		return invalid("path is required")
+	}
+	repo.reviewComment[c.GetID()] = c
+	iss.reviewComments = append(iss.reviewComments, c.GetID())
+	return http.StatusCreated, c
+}
+
+func (s *Server) newReviewComment(iss *issue, req *github.PullRequestComment) *github.PullRequestComment {
+	id := s.nextID()
+	commitID := req.CommitID
+	if commitID == nil {
+		commitID = iss.pr.Head.SHA
+	}
+	return &github.PullRequestComment{
+		ID:        github.Int64(id),
+		InReplyTo: req.InReplyTo,
+		Body:      req.Body,
+		Path:      req.Path,
+		Position:  req.Position,
+		CommitID:  commitID,
+		User:      s.user(s.Login),
+		HTMLURL:   github.String(iss.pr.GetHTMLURL() + "#discussion_r" + strconv.FormatInt(id, 10)),
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+}
+
+func (s *Server) getReviewComment(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	id, _ := strconv.ParseInt(p["id"], 10, 64)
+	c, ok := repo.reviewComment[id]
+	if !ok {
+		return notFound()
+	}
+	return http.StatusOK, c
+}
+
+func (s *Server) listReviews(_ *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupPullRequest(p)
+	if iss == nil {
+		return notFound()
+	}
+	reviews := iss.reviews
+	if reviews == nil {
+		reviews = []*github.PullRequestReview{}
+	}
+	return http.StatusOK, reviews
+}
+
+var reviewStates = map[string]string{
+	"":                "PENDING",
+	"APPROVE":         "APPROVED",
+	"REQUEST_CHANGES": "CHANGES_REQUESTED",
+	"COMMENT":         "COMMENTED",
+}
+
+func (s *Server) createReview(r *http.Request, p params) (int, interface{}) {
+	repo, iss := s.lookupPullRequest(p)
+	if iss == nil {
+		return notFound()
+	}
+	var req github.PullRequestReviewRequest
+	if !decode(r, &req) {
+		return invalid("invalid request")
+	}
+	state, ok := reviewStates[req.GetEvent()]
+	if !ok {
+		return invalid("unknown review event: " + req.GetEvent())
+	}
+	if state != "APPROVED" && state != "PENDING" && req.GetBody() == "" {
+		return invalid("body is required")
+	}
+	commitID := req.CommitID
+	if commitID == nil {
+		commitID = iss.pr.Head.SHA
+	}
+	review := &github.PullRequestReview{
+		ID:       github.Int64(s.nextID()),
+		User:     s.user(s.Login),
+		Body:     req.Body,
+		State:    github.String(state),
+		CommitID: commitID,
+		HTMLURL:  iss.pr.HTMLURL,
+	}
+	if state != "PENDING" {
+		review.SubmittedAt = now()
+	}
+	for _, dc := range req.Comments {
+		c := s.newReviewComment(iss, &github.PullRequestComment{
+			Body:     dc.Body,
+			Path:     dc.Path,
+			Position: dc.Position,
+			CommitID: commitID,
+		})
+		c.PullRequestReviewID = review.ID
+		repo.reviewComment[c.GetID()] = c
+		iss.reviewComments = append(iss.reviewComments, c.GetID())
+	}
+	iss.reviews = append(iss.reviews, review)
+	return http.StatusOK, review
+}
+
+func (s *Server) getReview(_ *http.Request, p params) (int, interface{}) {
+	_, iss := s.lookupPullRequest(p)
+	if iss == nil {
+		return notFound()
+	}
+	id, _ := strconv.ParseInt(p["id"], 10, 64)
+	for _, review := range iss.reviews {
+		if review.GetID() == id {
+			return http.StatusOK, review
+		}
+	}
+	return notFound()
+}
@@ -0,0 +1,289 @@
+// Package fakegithub is an in-process fake of the GitHub REST API, keeping
+// repositories, issues, pull requests, labels, comments, reviews, statuses,
+// check runs and events in memory. It answers the endpoints go-github uses
+// for them, so that hooks calling the API can be tested without network.
+package fakegithub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"github.com/nasa9084/ghbot"
+)
+
+// DefaultLogin is the user the fake acts as when objects are created
+// through the API.
+const DefaultLogin = "ghbot[bot]"
+
+// Server is a fake GitHub API server.
+type Server struct {
+	// URL is the base URL of the API, e.g. "http://127.0.0.1:1234/".
+	URL string
+	// Login is the user objects created through the API belong to.
+	Login string
+
+	srv    *httptest.Server
+	routes []route
+
+	mu     sync.Mutex
+	repos  map[string]*repository
+	lastID int64
+	events []*github.Event
+	// requests records "METHOD path" of every request served.
+	requests []string
+}
+
+type repository struct {
+	repo          *github.Repository
+	labels        map[string]*github.Label
+	issues        map[int]*issue
+	lastNumber    int
+	statuses      map[string][]*github.RepoStatus
+	checkRuns     []*github.CheckRun
+	permissions   map[string]string
+	issueComments map[int64]*github.IssueComment
+	reviewComment map[int64]*github.PullRequestComment
+	reactions     map[int64][]*github.Reaction
+}
+
+// issue is an issue, or the issue part of a pull request when pr is set.
+type issue struct {
+	issue          *github.Issue
+	pr             *github.PullRequest
+	comments       []int64
+	reviews        []*github.PullRequestReview
+	reviewComments []int64
+	files          []*github.CommitFile
+}
+
+// NewServer starts a fake server. It must be closed when done.
+func NewServer() *Server {
+	s := Server{
+		Login: DefaultLogin,
+		repos: map[string]*repository{},
+	}
+	s.registerRoutes()
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL + "/"
+	return &s
+}
+
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a client of the fake server. The server also answers
+// under /api/v3/, so that a bot whose EnterpriseBaseURL is
+// URL+"api/v3/" talks to it with clients of its own.
+func (s *Server) Client() *github.Client {
+	client := github.NewClient(s.srv.Client())
+	client.BaseURL, _ = url.Parse(s.URL)
+	client.UploadURL, _ = url.Parse(s.URL)
+	return client
+}
+
+// ClientFactory returns a factory which hands a client of the fake
+// server to every hook, for ghbot.Bot.SetClientFactory.
+func (s *Server) ClientFactory() ghbot.ClientFactory {
+	client := s.Client()
+	return func(context.Context, int64) (*github.Client, error) {
+		return client, nil
+	}
+}
+
+// Requests returns "METHOD path" of every request the server served, in
+// order.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+func (s *Server) user(login string) *github.User {
+	return &github.User{
+		Login: github.String(login),
+		Type:  github.String("User"),
+	}
+}
+
+func now() *time.Time {
+	t := time.Now().UTC().Truncate(time.Second)
+	return &t
+}
+
+// clone deep-copies src into dst, so that callers never share state with
+// the server.
+func clone(src, dst interface{}) {
+	b, err := json.Marshal(src)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(b, dst); err != nil {
+		panic(err)
+	}
+}
+
+type params map[string]string
+
+// handlerFunc serves a request with the server locked, returning the
+// status and the value to respond with as JSON.
+type handlerFunc func(r *http.Request, p params) (int, interface{})
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+// handle registers h for method and pattern, in which segments written
+// as "{name}" match anything and are passed to h.
+func (s *Server) handle(method, pattern string, h handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  h,
+	})
+}
+
+func (rt route) match(method string, segments []string) (params, bool) {
+	if rt.method != method || len(rt.segments) != len(segments) {
+		return nil, false
+	}
+	p := params{}
+	for i, seg := range rt.segments {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			p[seg[1:len(seg)-1]] = segments[i]
+			continue
+		}
+		if seg != segments[i] {
+			return nil, false
+		}
+	}
+	return p, true
+}
+
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+func notFound() (int, interface{}) {
+	return http.StatusNotFound, errorResponse{Message: "Not Found"}
+}
+
+func invalid(msg string) (int, interface{}) {
+	return http.StatusUnprocessableEntity, errorResponse{Message: msg}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.EscapedPath(), "/api/v3")
+	var segments []string
+	for _, seg := range strings.Split(strings.Trim(path, "/"), "/") {
+		if unescaped, err := url.PathUnescape(seg); err == nil {
+			seg = unescaped
+		}
+		segments = append(segments, seg)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.Method+" "+path)
+	status, body := notFound()
+	for _, rt := range s.routes {
+		if p, ok := rt.match(r.Method, segments); ok {
+			status, body = rt.handler(r, p)
+			break
+		}
+	}
+	if wh, ok := body.(withHeader); ok {
+		for k, v := range wh.header {
+			w.Header()[k] = v
+		}
+		body = wh.body
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if body != nil {
+		json.NewEncoder(w).Encode(body)
+	}
+}
+
+// withHeader is returned by handlers which send headers along with the
+// body, which may be nil.
+type withHeader struct {
+	header http.Header
+	body   interface{}
+}
+
+func decode(r *http.Request, v interface{}) bool {
+	return json.NewDecoder(r.Body).Decode(v) == nil
+}
+
+func (s *Server) registerRoutes() {
+	s.handle("GET", "repos/{owner}/{repo}", s.getRepo)
+
+	s.handle("GET", "repos/{owner}/{repo}/issues", s.listIssues)
+	s.handle("POST", "repos/{owner}/{repo}/issues", s.createIssue)
+	s.handle("GET", "repos/{owner}/{repo}/issues/comments/{id}", s.getIssueComment)
+	s.handle("PATCH", "repos/{owner}/{repo}/issues/comments/{id}", s.editIssueComment)
+	s.handle("DELETE", "repos/{owner}/{repo}/issues/comments/{id}", s.deleteIssueComment)
+	s.handle("GET", "repos/{owner}/{repo}/issues/comments/{id}/reactions", s.listReactions)
+	s.handle("POST", "repos/{owner}/{repo}/issues/comments/{id}/reactions", s.createReaction)
+	s.handle("GET", "repos/{owner}/{repo}/issues/{number}", s.getIssue)
+	s.handle("PATCH", "repos/{owner}/{repo}/issues/{number}", s.editIssue)
+	s.handle("GET", "repos/{owner}/{repo}/issues/{number}/comments", s.listIssueComments)
+	s.handle("POST", "repos/{owner}/{repo}/issues/{number}/comments", s.createIssueComment)
+	s.handle("GET", "repos/{owner}/{repo}/issues/{number}/labels", s.listIssueLabels)
+	s.handle("POST", "repos/{owner}/{repo}/issues/{number}/labels", s.addIssueLabels)
+	s.handle("PUT", "repos/{owner}/{repo}/issues/{number}/labels", s.replaceIssueLabels)
+	s.handle("DELETE", "repos/{owner}/{repo}/issues/{number}/labels", s.removeIssueLabels)
+	s.handle("DELETE", "repos/{owner}/{repo}/issues/{number}/labels/{name}", s.removeIssueLabel)
+
+	s.handle("GET", "repos/{owner}/{repo}/labels", s.listLabels)
+	s.handle("POST", "repos/{owner}/{repo}/labels", s.createLabel)
+	s.handle("GET", "repos/{owner}/{repo}/labels/{name}", s.getLabel)
+	s.handle("PATCH", "repos/{owner}/{repo}/labels/{name}", s.editLabel)
+	s.handle("DELETE", "repos/{owner}/{repo}/labels/{name}", s.deleteLabel)
+
+	s.handle("GET", "repos/{owner}/{repo}/pulls", s.listPullRequests)
+	s.handle("POST", "repos/{owner}/{repo}/pulls", s.createPullRequest)
+	s.handle("GET", "repos/{owner}/{repo}/pulls/comments/{id}", s.getReviewComment)
+	s.handle("GET", "repos/{owner}/{repo}/pulls/comments/{id}/reactions", s.listReactions)
+	s.handle("POST", "repos/{owner}/{repo}/pulls/comments/{id}/reactions", s.createReaction)
+	s.handle("GET", "repos/{owner}/{repo}/pulls/{number}", s.getPullRequest)
+	s.handle("PATCH", "repos/{owner}/{repo}/pulls/{number}", s.editPullRequest)
+	s.handle("GET", "repos/{owner}/{repo}/pulls/{number}/files", s.listPullRequestFiles)
+	s.handle("GET", "repos/{owner}/{repo}/pulls/{number}/merge", s.isMerged)
+	s.handle("PUT", "repos/{owner}/{repo}/pulls/{number}/merge", s.merge)
+	s.handle("GET", "repos/{owner}/{repo}/pulls/{number}/comments", s.listReviewComments)
+	s.handle("POST", "repos/{owner}/{repo}/pulls/{number}/comments", s.createReviewComment)
+	s.handle("GET", "repos/{owner}/{repo}/pulls/{number}/reviews", s.listReviews)
+	s.handle("POST", "repos/{owner}/{repo}/pulls/{number}/reviews", s.createReview)
+	s.handle("GET", "repos/{owner}/{repo}/pulls/{number}/reviews/{id}", s.getReview)
+
+	s.handle("POST", "repos/{owner}/{repo}/statuses/{sha}", s.createStatus)
+	s.handle("GET", "repos/{owner}/{repo}/commits/{ref}/statuses", s.listStatuses)
+	s.handle("GET", "repos/{owner}/{repo}/commits/{ref}/status", s.combinedStatus)
+	s.handle("POST", "repos/{owner}/{repo}/check-runs", s.createCheckRun)
+	s.handle("GET", "repos/{owner}/{repo}/check-runs/{id}", s.getCheckRun)
+	s.handle("PATCH", "repos/{owner}/{repo}/check-runs/{id}", s.updateCheckRun)
+	s.handle("GET", "repos/{owner}/{repo}/commits/{ref}/check-runs", s.listCheckRuns)
+
+	s.handle("GET", "repos/{owner}/{repo}/collaborators/{user}/permission", s.getPermission)
+
+	s.handle("GET", "repos/{owner}/{repo}/events", s.listRepoEvents)
+	s.handle("GET", "orgs/{org}/events", s.listOrgEvents)
+}
@@ -0,0 +1,215 @@
+package fakegithub_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+	"github.com/nasa9084/ghbot"
+	"github.com/nasa9084/ghbot/fakegithub"
+	"github.com/nasa9084/ghbot/ghbottest"
+)
+
+// newHarness returns a harness whose bot hands clients of srv to hooks.
+func newHarness(srv *fakegithub.Server) (*ghbot.Bot, *ghbottest.Harness) {
+	bot := ghbot.New(ghbot.Config{WebHookSecret: "secret"})
+	bot.SetClientFactory(srv.ClientFactory())
+	return bot, ghbottest.New(bot, "secret")
+}
+
+func TestLabelsAndReactions(t *testing.T) {
+	srv := fakegithub.NewServer()
+	defer srv.Close()
+	number := srv.AddPullRequest(ghbottest.DefaultRepo, &github.PullRequest{Title: github.String("Fix")})
+
+	bot, h := newHarness(srv)
+	var commentID int64
+	bot.AddIssueCommentEventHook(func(ctx context.Context, e *github.IssueCommentEvent) error {
+		client := ghbot.ClientFromContext(ctx)
+		owner, repo := e.GetRepo().GetOwner().GetLogin(), e.GetRepo().GetName()
+		commentID = e.GetComment().GetID()
+		if _, _, err := client.Issues.AddLabelsToIssue(ctx, owner, repo, e.GetIssue().GetNumber(), []string{"lgtm"}); err != nil {
+			return err
+		}
+		// the comment of the delivery is unknown to the server
+		if _, _, err := client.Reactions.CreateIssueCommentReaction(ctx, owner, repo, commentID, "+1"); err != nil {
+			return err
+		}
+		_, _, err := client.Issues.CreateComment(ctx, owner, repo, e.GetIssue().GetNumber(), &github.IssueComment{Body: github.String("LGTM label has been added.")})
+		return err
+	})
+	h.MustSend(t, ghbottest.IssueCommentCreated("/lgtm").Number(number).OnPullRequest().Delivery())
+
+	if !srv.HasLabel(ghbottest.DefaultRepo, number, "LGTM") {
+		t.Errorf("labels of #%d = %v", number, srv.Labels(ghbottest.DefaultRepo, number))
+	}
+	if got := srv.Reactions(ghbottest.DefaultRepo, commentID); len(got) != 1 || got[0] != "+1" {
+		t.Errorf("reactions = %v", got)
+	}
+	if comments := srv.Comments(ghbottest.DefaultRepo, number); len(comments) != 1 || comments[0].GetUser().GetLogin() != fakegithub.DefaultLogin {
+		t.Errorf("comments = %+v", comments)
+	}
+
+	// the label was created in the repository as GitHub does
+	ctx := context.Background()
+	client := srv.Client()
+	label, _, err := client.Issues.GetLabel(ctx, "octocat", "hello-world", "lgtm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if label.GetName() != "lgtm" || label.GetColor() != "ededed" {
+		t.Errorf("label = %+v", label)
+	}
+
+	// reacting twice does not add another reaction
+	if _, _, err := client.Reactions.CreateIssueCommentReaction(ctx, "octocat", "hello-world", commentID, "+1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.Reactions(ghbottest.DefaultRepo, commentID); len(got) != 1 {
+		t.Errorf("reactions = %v", got)
+	}
+}
+
+func TestRemoveLabel(t *testing.T) {
+	srv := fakegithub.NewServer()
+	defer srv.Close()
+	number := srv.AddIssue(ghbottest.DefaultRepo, &github.Issue{
+		Title:  github.String("Broken"),
+		Labels: []github.Label{{Name: github.String("bug")}, {Name: github.String("lgtm")}},
+	})
+
+	bot, h := newHarness(srv)
+	bot.AddIssueCommentEventHook(func(ctx context.Context, e *github.IssueCommentEvent) error {
+		label := strings.TrimPrefix(e.GetComment().GetBody(), "/remove ")
+		_, err := ghbot.ClientFromContext(ctx).Issues.RemoveLabelForIssue(ctx, "octocat", "hello-world", e.GetIssue().GetNumber(), label)
+		return err
+	}, ghbot.WithName("remove"))
+
+	h.MustSend(t, ghbottest.IssueCommentCreated("/remove LGTM").Number(number).Delivery())
+	if got := srv.Labels(ghbottest.DefaultRepo, number); len(got) != 1 || got[0] != "bug" {
+		t.Errorf("labels = %v", got)
+	}
+
+	// removing a label the issue does not have fails with 404
+	result := h.Send(ghbottest.IssueCommentCreated("/remove lgtm").Number(number).Delivery())
+	errResp, ok := result.Err("remove").(*github.ErrorResponse)
+	if !ok || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("error = %v, want a 404", result.Err("remove"))
+	}
+	if got := srv.Labels(ghbottest.DefaultRepo, number); len(got) != 1 {
+		t.Errorf("labels = %v", got)
+	}
+}
+
+func TestListIssues(t *testing.T) {
+	srv := fakegithub.NewServer()
+	defer srv.Close()
+	for _, title := range []string{"first", "second", "third"} {
+		srv.AddIssue(ghbottest.DefaultRepo, &github.Issue{Title: github.String(title)})
+	}
+	srv.AddIssue(ghbottest.DefaultRepo, &github.Issue{Title: github.String("closed"), State: github.String("closed")})
+
+	ctx := context.Background()
+	client := srv.Client()
+	titles := func(state string) string {
+		t.Helper()
+		issues, _, err := client.Issues.ListByRepo(ctx, "octocat", "hello-world", &github.IssueListByRepoOptions{State: state})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var titles []string
+		for _, iss := range issues {
+			titles = append(titles, iss.GetTitle())
+		}
+		return strings.Join(titles, ", ")
+	}
+	// newest first, open ones by default
+	if got := titles(""); got != "third, second, first" {
+		t.Errorf("open issues = %s", got)
+	}
+	if got := titles("closed"); got != "closed" {
+		t.Errorf("closed issues = %s", got)
+	}
+	if got := titles("all"); got != "closed, third, second, first" {
+		t.Errorf("all issues = %s", got)
+	}
+	if _, _, err := client.Issues.ListByRepo(ctx, "octocat", "unknown", nil); err == nil {
+		t.Error("issues of an unknown repository were listed")
+	}
+}
+
+func TestReviewsStatusesAndChecks(t *testing.T) {
+	srv := fakegithub.NewServer()
+	defer srv.Close()
+	number := srv.AddPullRequest(ghbottest.DefaultRepo, &github.PullRequest{
+		Title: github.String("Fix"),
+		Head:  &github.PullRequestBranch{Ref: github.String("topic"), SHA: github.String(strings.Repeat("1", 40))},
+	})
+
+	bot, h := newHarness(srv)
+	bot.AddPullRequestEventHook(func(ctx context.Context, e *github.PullRequestEvent) error {
+		client := ghbot.ClientFromContext(ctx)
+		sha := e.GetPullRequest().GetHead().GetSHA()
+		if _, _, err := client.Repositories.CreateStatus(ctx, "octocat", "hello-world", sha, &github.RepoStatus{
+			State: github.String("success"), Context: github.String("ci"),
+		}); err != nil {
+			return err
+		}
+		if _, _, err := client.Checks.CreateCheckRun(ctx, "octocat", "hello-world", github.CreateCheckRunOptions{
+			Name: "lint", HeadSHA: sha, Status: github.String("completed"), Conclusion: github.String("success"),
+		}); err != nil {
+			return err
+		}
+		if _, _, err := client.PullRequests.CreateReview(ctx, "octocat", "hello-world", e.GetNumber(), &github.PullRequestReviewRequest{
+			Event: github.String("APPROVE"),
+		}); err != nil {
+			return err
+		}
+		_, _, err := client.PullRequests.Merge(ctx, "octocat", "hello-world", e.GetNumber(), "", &github.PullRequestOptions{SHA: sha})
+		return err
+	})
+	h.MustSend(t, ghbottest.PullRequestOpened().Number(number).Delivery())
+
+	sha := strings.Repeat("1", 40)
+	if statuses := srv.Statuses(ghbottest.DefaultRepo, sha); len(statuses) != 1 || statuses[0].GetState() != "success" {
+		t.Errorf("statuses = %+v", statuses)
+	}
+	if runs := srv.CheckRuns(ghbottest.DefaultRepo, "topic"); len(runs) != 1 || runs[0].GetConclusion() != "success" {
+		t.Errorf("check runs = %+v", runs)
+	}
+	if reviews := srv.Reviews(ghbottest.DefaultRepo, number); len(reviews) != 1 || reviews[0].GetState() != "APPROVED" {
+		t.Errorf("reviews = %+v", reviews)
+	}
+	if pr := srv.PullRequest(ghbottest.DefaultRepo, number); !pr.GetMerged() || pr.GetState() != "closed" {
+		t.Errorf("pull request = %+v", pr)
+	}
+	combined, _, err := srv.Client().Repositories.GetCombinedStatus(context.Background(), "octocat", "hello-world", "topic", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if combined.GetState() != "success" || combined.GetSHA() != sha {
+		t.Errorf("combined status = %+v", combined)
+	}
+}
+
+func TestEnterpriseClients(t *testing.T) {
+	srv := fakegithub.NewServer()
+	defer srv.Close()
+	srv.AddRepo(ghbottest.DefaultRepo)
+
+	// a bot with credentials of its own talks to the server under /api/v3/
+	bot := ghbot.New(ghbot.Config{WebHookSecret: "secret", GitHubToken: "s3cr3t", EnterpriseBaseURL: srv.URL + "api/v3/"})
+	bot.AddPushEventHook(func(ctx context.Context, e *github.PushEvent) error {
+		_, _, err := ghbot.ClientFromContext(ctx).Issues.Create(ctx, "octocat", "hello-world", &github.IssueRequest{Title: github.String("Pushed")})
+		return err
+	})
+	ghbottest.New(bot, "secret").MustSend(t, ghbottest.PushTo(ghbottest.DefaultBranch).Delivery())
+	if iss := srv.Issue(ghbottest.DefaultRepo, 1); iss.GetTitle() != "Pushed" {
+		t.Errorf("issue = %+v", iss)
+	}
+	if requests := srv.Requests(); len(requests) != 1 || requests[0] != "POST /repos/octocat/hello-world/issues" {
+		t.Errorf("requests = %v", requests)
+	}
+}
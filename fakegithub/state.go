@@ -0,0 +1,371 @@
+package fakegithub
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v25/github"
+)
+
+func splitFullName(fullName string) (string, string) {
+	if i := strings.Index(fullName, "/"); i >= 0 {
+		return fullName[:i], fullName[i+1:]
+	}
+	return "", fullName
+}
+
+// AddRepo adds an empty repository, written as "owner/name", unless it
+// exists already.
+func (s *Server) AddRepo(fullName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addRepo(fullName)
+}
+
+func (s *Server) addRepo(fullName string) *repository {
+	key := strings.ToLower(fullName)
+	if repo, ok := s.repos[key]; ok {
+		return repo
+	}
+	owner, name := splitFullName(fullName)
+	repo := &repository{
+		repo: &github.Repository{
+			ID:            github.Int64(s.nextID()),
+			Owner:         s.user(owner),
+			Name:          github.String(name),
+			FullName:      github.String(fullName),
+			DefaultBranch: github.String("master"),
+			HTMLURL:       github.String("https://github.com/" + fullName),
+		},
+		labels:        map[string]*github.Label{},
+		issues:        map[int]*issue{},
+		statuses:      map[string][]*github.RepoStatus{},
+		permissions:   map[string]string{},
+		issueComments: map[int64]*github.IssueComment{},
+		reviewComment: map[int64]*github.PullRequestComment{},
+		reactions:     map[int64][]*github.Reaction{},
+	}
+	s.repos[key] = repo
+	return repo
+}
+
+func (s *Server) lookupRepo(p params) *repository {
+	return s.repos[strings.ToLower(p["owner"]+"/"+p["repo"])]
+}
+
+// lookupIssue returns the issue or pull request numbered p["number"].
+func (s *Server) lookupIssue(p params) (*repository, *issue) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return nil, nil
+	}
+	number, err := strconv.Atoi(p["number"])
+	if err != nil {
+		return repo, nil
+	}
+	return repo, repo.issues[number]
+}
+
+func (repo *repository) newIssue(s *Server, title, body, author string) *issue {
+	repo.lastNumber++
+	number := repo.lastNumber
+	iss := &issue{
+		issue: &github.Issue{
+			ID:        github.Int64(s.nextID()),
+			Number:    github.Int(number),
+			State:     github.String("open"),
+			Title:     github.String(title),
+			Body:      github.String(body),
+			User:      s.user(author),
+			HTMLURL:   github.String(fmt.Sprintf("%s/issues/%d", repo.repo.GetHTMLURL(), number)),
+			CreatedAt: now(),
+			UpdatedAt: now(),
+		},
+	}
+	repo.issues[number] = iss
+	return iss
+}
+
+// label returns the label of the repository named name, creating it as
+// GitHub does when a missing label is added to an issue.
+func (repo *repository) label(s *Server, name string) *github.Label {
+	key := strings.ToLower(name)
+	if l, ok := repo.labels[key]; ok {
+		return l
+	}
+	l := &github.Label{
+		ID:    github.Int64(s.nextID()),
+		Name:  github.String(name),
+		Color: github.String("ededed"),
+	}
+	repo.labels[key] = l
+	return l
+}
+
+// AddIssue adds an issue to the repository, which is created if needed,
+// and returns its number. Number, state and timestamps are filled in.
+func (s *Server) AddIssue(fullName string, iss *github.Issue) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.addRepo(fullName)
+	author := iss.GetUser().GetLogin()
+	if author == "" {
+		author = s.Login
+	}
+	created := repo.newIssue(s, iss.GetTitle(), iss.GetBody(), author)
+	for _, l := range iss.Labels {
+		created.issue.Labels = append(created.issue.Labels, *repo.label(s, l.GetName()))
+	}
+	if iss.State != nil {
+		created.issue.State = iss.State
+	}
+	return created.issue.GetNumber()
+}
+
+// AddPullRequest adds a pull request to the repository, which is created
+// if needed, and returns its number. Files are what the files endpoint
+// lists.
+func (s *Server) AddPullRequest(fullName string, pr *github.PullRequest, files ...*github.CommitFile) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.addRepo(fullName)
+	author := pr.GetUser().GetLogin()
+	if author == "" {
+		author = s.Login
+	}
+	iss := repo.newPullRequest(s, pr.GetTitle(), pr.GetBody(), author, pr.GetHead().GetRef(), pr.GetBase().GetRef())
+	if sha := pr.GetHead().GetSHA(); sha != "" {
+		iss.pr.Head.SHA = github.String(sha)
+	}
+	iss.pr.Draft = pr.Draft
+	for _, l := range pr.Labels {
+		iss.issue.Labels = append(iss.issue.Labels, *repo.label(s, l.GetName()))
+	}
+	for _, f := range files {
+		var file github.CommitFile
+		clone(f, &file)
+		iss.files = append(iss.files, &file)
+	}
+	return iss.issue.GetNumber()
+}
+
+func (repo *repository) newPullRequest(s *Server, title, body, author, head, base string) *issue {
+	if base == "" {
+		base = repo.repo.GetDefaultBranch()
+	}
+	iss := repo.newIssue(s, title, body, author)
+	number := iss.issue.GetNumber()
+	htmlURL := fmt.Sprintf("%s/pull/%d", repo.repo.GetHTMLURL(), number)
+	iss.issue.HTMLURL = github.String(htmlURL)
+	iss.issue.PullRequestLinks = &github.PullRequestLinks{HTMLURL: github.String(htmlURL)}
+	iss.pr = &github.PullRequest{
+		ID:      github.Int64(s.nextID()),
+		Number:  github.Int(number),
+		HTMLURL: github.String(htmlURL),
+		Merged:  github.Bool(false),
+		Head: &github.PullRequestBranch{
+			Ref:  github.String(head),
+			SHA:  github.String(fmt.Sprintf("%040x", s.nextID())),
+			Repo: repo.repo,
+		},
+		Base: &github.PullRequestBranch{
+			Ref:  github.String(base),
+			SHA:  github.String(fmt.Sprintf("%040x", s.nextID())),
+			Repo: repo.repo,
+		},
+	}
+	return iss
+}
+
+// SetPermission sets the collaborator permission of user on the
+// repository, e.g. "admin", "write" or "read".
+func (s *Server) SetPermission(fullName, user, permission string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addRepo(fullName).permissions[strings.ToLower(user)] = permission
+}
+
+func (s *Server) getRepo(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	return http.StatusOK, repo.repo
+}
+
+func (s *Server) getPermission(_ *http.Request, p params) (int, interface{}) {
+	repo := s.lookupRepo(p)
+	if repo == nil {
+		return notFound()
+	}
+	perm, ok := repo.permissions[strings.ToLower(p["user"])]
+	if !ok {
+		perm = "none"
+	}
+	return http.StatusOK, &github.RepositoryPermissionLevel{
+		Permission: github.String(perm),
+		User:       s.user(p["user"]),
+	}
+}
+
+// Issue returns a copy of the issue or the issue part of the pull
+// request, or nil when it does not exist.
+func (s *Server) Issue(fullName string, number int) *github.Issue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo, iss := s.lookupIssue(params{"owner": ownerOf(fullName), "repo": nameOf(fullName), "number": strconv.Itoa(number)})
+	if repo == nil || iss == nil {
+		return nil
+	}
+	var out github.Issue
+	clone(iss.issue, &out)
+	return &out
+}
+
+// PullRequest returns a copy of the pull request, or nil when it does not
+// exist.
+func (s *Server) PullRequest(fullName string, number int) *github.PullRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, iss := s.lookupIssue(params{"owner": ownerOf(fullName), "repo": nameOf(fullName), "number": strconv.Itoa(number)})
+	if iss == nil || iss.pr == nil {
+		return nil
+	}
+	var out github.PullRequest
+	clone(iss.pullRequest(), &out)
+	return &out
+}
+
+// Labels returns the sorted names of the labels of the issue or pull
+// request.
+func (s *Server) Labels(fullName string, number int) []string {
+	iss := s.Issue(fullName, number)
+	if iss == nil {
+		return nil
+	}
+	var names []string
+	for _, l := range iss.Labels {
+		names = append(names, l.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasLabel tells whether the issue or pull request has the label.
+func (s *Server) HasLabel(fullName string, number int, label string) bool {
+	for _, name := range s.Labels(fullName, number) {
+		if strings.EqualFold(name, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// Comments returns copies of the comments on the issue or on the
+// conversation of the pull request, oldest first.
+func (s *Server) Comments(fullName string, number int) []*github.IssueComment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo, iss := s.lookupIssue(params{"owner": ownerOf(fullName), "repo": nameOf(fullName), "number": strconv.Itoa(number)})
+	if iss == nil {
+		return nil
+	}
+	var out []*github.IssueComment
+	clone(repo.commentsOf(iss), &out)
+	return out
+}
+
+// Reviews returns copies of the reviews of the pull request, oldest
+// first.
+func (s *Server) Reviews(fullName string, number int) []*github.PullRequestReview {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, iss := s.lookupIssue(params{"owner": ownerOf(fullName), "repo": nameOf(fullName), "number": strconv.Itoa(number)})
+	if iss == nil {
+		return nil
+	}
+	var out []*github.PullRequestReview
+	clone(iss.reviews, &out)
+	return out
+}
+
+// ReviewComments returns copies of the review comments of the pull
+// request, oldest first.
+func (s *Server) ReviewComments(fullName string, number int) []*github.PullRequestComment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo, iss := s.lookupIssue(params{"owner": ownerOf(fullName), "repo": nameOf(fullName), "number": strconv.Itoa(number)})
+	if iss == nil {
+		return nil
+	}
+	var out []*github.PullRequestComment
+	clone(repo.reviewCommentsOf(iss), &out)
+	return out
+}
+
+// Reactions returns the contents of the reactions to the issue or review
+// comment with id.
+func (s *Server) Reactions(fullName string, commentID int64) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.repos[strings.ToLower(fullName)]
+	if repo == nil {
+		return nil
+	}
+	var contents []string
+	for _, r := range repo.reactions[commentID] {
+		contents = append(contents, r.GetContent())
+	}
+	return contents
+}
+
+// Statuses returns copies of the statuses of the commit, newest first.
+func (s *Server) Statuses(fullName, sha string) []*github.RepoStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.repos[strings.ToLower(fullName)]
+	if repo == nil {
+		return nil
+	}
+	var out []*github.RepoStatus
+	clone(repo.statusesOf(sha), &out)
+	return out
+}
+
+// CheckRuns returns copies of the check runs of the commit.
+func (s *Server) CheckRuns(fullName, sha string) []*github.CheckRun {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.repos[strings.ToLower(fullName)]
+	if repo == nil {
+		return nil
+	}
+	var out []*github.CheckRun
+	clone(repo.checkRunsOf(sha), &out)
+	return out
+}
+
+func ownerOf(fullName string) string {
+	owner, _ := splitFullName(fullName)
+	return owner
+}
+
+func nameOf(fullName string) string {
+	_, name := splitFullName(fullName)
+	return name
+}
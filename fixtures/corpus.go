@@ -0,0 +1,10069 @@
+package fixtures
+
+// corpus holds webhook payloads modeled on deliveries of github.com. IDs,
+// logins and URLs are anonymized to the octo-org organization and the
+// octocat user.
+var corpus = []fixture{
+	{
+		name:       "branch_protection_rule.created",
+		event:      "branch_protection_rule",
+		action:     "created",
+		triggerLog: `{"type":"branch_protection_rule","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "rule": {
+		    "id": 21796960,
+		    "repository_id": 186853002,
+		    "name": "main",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "pull_request_reviews_enforcement_level": "off",
+		    "required_approving_review_count": 0,
+		    "dismiss_stale_reviews_on_push": false,
+		    "require_code_owner_review": false,
+		    "authorized_dismissal_actors_only": false,
+		    "ignore_approvals_from_contributors": false,
+		    "required_status_checks": [
+		      "build"
+		    ],
+		    "required_status_checks_enforcement_level": "non_admins",
+		    "strict_required_status_checks_policy": true,
+		    "signature_requirement_enforcement_level": "off",
+		    "linear_history_requirement_enforcement_level": "off",
+		    "admin_enforced": false,
+		    "allow_force_pushes_enforcement_level": "off",
+		    "allow_deletions_enforcement_level": "off",
+		    "merge_queue_enforcement_level": "off",
+		    "required_deployments_enforcement_level": "off",
+		    "required_conversation_resolution_level": "off",
+		    "authorized_actors_only": false,
+		    "authorized_actor_names": []
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "branch_protection_rule.edited",
+		event:      "branch_protection_rule",
+		action:     "edited",
+		triggerLog: `{"type":"branch_protection_rule","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "edited",
+		  "rule": {
+		    "id": 21796960,
+		    "repository_id": 186853002,
+		    "name": "main",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "pull_request_reviews_enforcement_level": "off",
+		    "required_approving_review_count": 0,
+		    "dismiss_stale_reviews_on_push": false,
+		    "require_code_owner_review": false,
+		    "authorized_dismissal_actors_only": false,
+		    "ignore_approvals_from_contributors": false,
+		    "required_status_checks": [
+		      "build"
+		    ],
+		    "required_status_checks_enforcement_level": "non_admins",
+		    "strict_required_status_checks_policy": true,
+		    "signature_requirement_enforcement_level": "off",
+		    "linear_history_requirement_enforcement_level": "off",
+		    "admin_enforced": false,
+		    "allow_force_pushes_enforcement_level": "off",
+		    "allow_deletions_enforcement_level": "off",
+		    "merge_queue_enforcement_level": "off",
+		    "required_deployments_enforcement_level": "off",
+		    "required_conversation_resolution_level": "off",
+		    "authorized_actors_only": false,
+		    "authorized_actor_names": []
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "branch_protection_rule.deleted",
+		event:      "branch_protection_rule",
+		action:     "deleted",
+		triggerLog: `{"type":"branch_protection_rule","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "deleted",
+		  "rule": {
+		    "id": 21796960,
+		    "repository_id": 186853002,
+		    "name": "main",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "pull_request_reviews_enforcement_level": "off",
+		    "required_approving_review_count": 0,
+		    "dismiss_stale_reviews_on_push": false,
+		    "require_code_owner_review": false,
+		    "authorized_dismissal_actors_only": false,
+		    "ignore_approvals_from_contributors": false,
+		    "required_status_checks": [
+		      "build"
+		    ],
+		    "required_status_checks_enforcement_level": "non_admins",
+		    "strict_required_status_checks_policy": true,
+		    "signature_requirement_enforcement_level": "off",
+		    "linear_history_requirement_enforcement_level": "off",
+		    "admin_enforced": false,
+		    "allow_force_pushes_enforcement_level": "off",
+		    "allow_deletions_enforcement_level": "off",
+		    "merge_queue_enforcement_level": "off",
+		    "required_deployments_enforcement_level": "off",
+		    "required_conversation_resolution_level": "off",
+		    "authorized_actors_only": false,
+		    "authorized_actor_names": []
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "check_run.created",
+		event:      "check_run",
+		action:     "created",
+		triggerLog: `{"type":"check_run","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "check_run": {
+		    "id": 128620228,
+		    "node_id": "MDg6Q2hlY2tSdW4xMjg2MjAyMjg=",
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "external_id": "",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/check-runs/128620228",
+		    "html_url": "https://github.com/octo-org/hello-world/runs/128620228",
+		    "details_url": "https://octo-bot.example.com",
+		    "status": "queued",
+		    "conclusion": null,
+		    "started_at": "2019-05-15T15:20:33Z",
+		    "completed_at": null,
+		    "output": {
+		      "title": null,
+		      "summary": null,
+		      "text": null,
+		      "annotations_count": 0
+		    },
+		    "name": "Octocoders-linter",
+		    "check_suite": {
+		      "id": 118578147,
+		      "node_id": "MDEwOkNoZWNrU3VpdGUxMTg1NzgxNDc=",
+		      "head_branch": "changes",
+		      "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "status": "completed",
+		      "conclusion": "success",
+		      "url": "https://api.github.com/repos/octo-org/hello-world/check-suites/118578147",
+		      "before": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "after": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "pull_requests": [],
+		      "app": {
+		        "id": 29310,
+		        "node_id": "MDM6QXBwMjkzMTA=",
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "name": "octo-bot",
+		        "slug": "octo-bot",
+		        "external_url": "https://octo-bot.example.com",
+		        "html_url": "https://github.com/apps/octo-bot",
+		        "created_at": "2019-05-15T15:20:33Z",
+		        "updated_at": "2019-05-15T15:20:33Z"
+		      },
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:21:14Z"
+		    },
+		    "app": {
+		      "id": 29310,
+		      "node_id": "MDM6QXBwMjkzMTA=",
+		      "owner": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "name": "octo-bot",
+		      "slug": "octo-bot",
+		      "external_url": "https://octo-bot.example.com",
+		      "html_url": "https://github.com/apps/octo-bot",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z"
+		    },
+		    "pull_requests": []
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "check_run.completed",
+		event:      "check_run",
+		action:     "completed",
+		triggerLog: `{"type":"check_run","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "completed",
+		  "check_run": {
+		    "id": 128620228,
+		    "node_id": "MDg6Q2hlY2tSdW4xMjg2MjAyMjg=",
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "external_id": "",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/check-runs/128620228",
+		    "html_url": "https://github.com/octo-org/hello-world/runs/128620228",
+		    "details_url": "https://octo-bot.example.com",
+		    "status": "completed",
+		    "conclusion": "success",
+		    "started_at": "2019-05-15T15:20:33Z",
+		    "completed_at": "2019-05-15T15:21:14Z",
+		    "output": {
+		      "title": null,
+		      "summary": null,
+		      "text": null,
+		      "annotations_count": 0
+		    },
+		    "name": "Octocoders-linter",
+		    "check_suite": {
+		      "id": 118578147,
+		      "node_id": "MDEwOkNoZWNrU3VpdGUxMTg1NzgxNDc=",
+		      "head_branch": "changes",
+		      "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "status": "completed",
+		      "conclusion": "success",
+		      "url": "https://api.github.com/repos/octo-org/hello-world/check-suites/118578147",
+		      "before": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "after": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "pull_requests": [],
+		      "app": {
+		        "id": 29310,
+		        "node_id": "MDM6QXBwMjkzMTA=",
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "name": "octo-bot",
+		        "slug": "octo-bot",
+		        "external_url": "https://octo-bot.example.com",
+		        "html_url": "https://github.com/apps/octo-bot",
+		        "created_at": "2019-05-15T15:20:33Z",
+		        "updated_at": "2019-05-15T15:20:33Z"
+		      },
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:21:14Z"
+		    },
+		    "app": {
+		      "id": 29310,
+		      "node_id": "MDM6QXBwMjkzMTA=",
+		      "owner": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "name": "octo-bot",
+		      "slug": "octo-bot",
+		      "external_url": "https://octo-bot.example.com",
+		      "html_url": "https://github.com/apps/octo-bot",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z"
+		    },
+		    "pull_requests": []
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "check_run.rerequested",
+		event:      "check_run",
+		action:     "rerequested",
+		triggerLog: `{"type":"check_run","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "rerequested",
+		  "check_run": {
+		    "id": 128620228,
+		    "node_id": "MDg6Q2hlY2tSdW4xMjg2MjAyMjg=",
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "external_id": "",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/check-runs/128620228",
+		    "html_url": "https://github.com/octo-org/hello-world/runs/128620228",
+		    "details_url": "https://octo-bot.example.com",
+		    "status": "completed",
+		    "conclusion": "success",
+		    "started_at": "2019-05-15T15:20:33Z",
+		    "completed_at": "2019-05-15T15:21:14Z",
+		    "output": {
+		      "title": null,
+		      "summary": null,
+		      "text": null,
+		      "annotations_count": 0
+		    },
+		    "name": "Octocoders-linter",
+		    "check_suite": {
+		      "id": 118578147,
+		      "node_id": "MDEwOkNoZWNrU3VpdGUxMTg1NzgxNDc=",
+		      "head_branch": "changes",
+		      "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "status": "completed",
+		      "conclusion": "success",
+		      "url": "https://api.github.com/repos/octo-org/hello-world/check-suites/118578147",
+		      "before": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "after": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "pull_requests": [],
+		      "app": {
+		        "id": 29310,
+		        "node_id": "MDM6QXBwMjkzMTA=",
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "name": "octo-bot",
+		        "slug": "octo-bot",
+		        "external_url": "https://octo-bot.example.com",
+		        "html_url": "https://github.com/apps/octo-bot",
+		        "created_at": "2019-05-15T15:20:33Z",
+		        "updated_at": "2019-05-15T15:20:33Z"
+		      },
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:21:14Z"
+		    },
+		    "app": {
+		      "id": 29310,
+		      "node_id": "MDM6QXBwMjkzMTA=",
+		      "owner": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "name": "octo-bot",
+		      "slug": "octo-bot",
+		      "external_url": "https://octo-bot.example.com",
+		      "html_url": "https://github.com/apps/octo-bot",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z"
+		    },
+		    "pull_requests": []
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "check_suite.requested",
+		event:      "check_suite",
+		action:     "requested",
+		triggerLog: `{"type":"check_suite","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "requested",
+		  "check_suite": {
+		    "id": 118578147,
+		    "node_id": "MDEwOkNoZWNrU3VpdGUxMTg1NzgxNDc=",
+		    "head_branch": "changes",
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "status": "queued",
+		    "conclusion": null,
+		    "url": "https://api.github.com/repos/octo-org/hello-world/check-suites/118578147",
+		    "before": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		    "after": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "pull_requests": [],
+		    "app": {
+		      "id": 29310,
+		      "node_id": "MDM6QXBwMjkzMTA=",
+		      "owner": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "name": "octo-bot",
+		      "slug": "octo-bot",
+		      "external_url": "https://octo-bot.example.com",
+		      "html_url": "https://github.com/apps/octo-bot",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z"
+		    },
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "check_suite.completed",
+		event:      "check_suite",
+		action:     "completed",
+		triggerLog: `{"type":"check_suite","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "completed",
+		  "check_suite": {
+		    "id": 118578147,
+		    "node_id": "MDEwOkNoZWNrU3VpdGUxMTg1NzgxNDc=",
+		    "head_branch": "changes",
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "status": "completed",
+		    "conclusion": "success",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/check-suites/118578147",
+		    "before": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		    "after": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "pull_requests": [],
+		    "app": {
+		      "id": 29310,
+		      "node_id": "MDM6QXBwMjkzMTA=",
+		      "owner": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "name": "octo-bot",
+		      "slug": "octo-bot",
+		      "external_url": "https://octo-bot.example.com",
+		      "html_url": "https://github.com/apps/octo-bot",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z"
+		    },
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "code_scanning_alert.created",
+		event:      "code_scanning_alert",
+		action:     "created",
+		triggerLog: `{"type":"code_scanning_alert","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "alert": {
+		    "number": 10,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/code-scanning/alerts/10",
+		    "html_url": "https://github.com/octo-org/hello-world/security/code-scanning/10",
+		    "state": "open",
+		    "dismissed_by": null,
+		    "dismissed_at": null,
+		    "dismissed_reason": null,
+		    "rule": {
+		      "id": "js/unused-local-variable",
+		      "severity": "note",
+		      "description": "Unused variable, import, function or class",
+		      "name": "js/unused-local-variable",
+		      "tags": [
+		        "maintainability"
+		      ]
+		    },
+		    "tool": {
+		      "name": "CodeQL",
+		      "guid": null,
+		      "version": "2.4.0"
+		    },
+		    "most_recent_instance": {
+		      "ref": "refs/heads/main",
+		      "analysis_key": ".github/workflows/codeql.yml:analyze",
+		      "environment": "{}",
+		      "category": ".github/workflows/codeql.yml:analyze",
+		      "state": "open",
+		      "commit_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "message": {
+		        "text": "Unused variable foo."
+		      },
+		      "location": {
+		        "path": "index.js",
+		        "start_line": 1,
+		        "end_line": 1,
+		        "start_column": 5,
+		        "end_column": 8
+		      },
+		      "classifications": []
+		    },
+		    "instances_url": "https://api.github.com/repos/octo-org/hello-world/code-scanning/alerts/10/instances"
+		  },
+		  "ref": "refs/heads/main",
+		  "commit_oid": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "code_scanning_alert.fixed",
+		event:      "code_scanning_alert",
+		action:     "fixed",
+		triggerLog: `{"type":"code_scanning_alert","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "fixed",
+		  "alert": {
+		    "number": 10,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/code-scanning/alerts/10",
+		    "html_url": "https://github.com/octo-org/hello-world/security/code-scanning/10",
+		    "state": "fixed",
+		    "dismissed_by": null,
+		    "dismissed_at": null,
+		    "dismissed_reason": null,
+		    "rule": {
+		      "id": "js/unused-local-variable",
+		      "severity": "note",
+		      "description": "Unused variable, import, function or class",
+		      "name": "js/unused-local-variable",
+		      "tags": [
+		        "maintainability"
+		      ]
+		    },
+		    "tool": {
+		      "name": "CodeQL",
+		      "guid": null,
+		      "version": "2.4.0"
+		    },
+		    "most_recent_instance": {
+		      "ref": "refs/heads/main",
+		      "analysis_key": ".github/workflows/codeql.yml:analyze",
+		      "environment": "{}",
+		      "category": ".github/workflows/codeql.yml:analyze",
+		      "state": "open",
+		      "commit_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "message": {
+		        "text": "Unused variable foo."
+		      },
+		      "location": {
+		        "path": "index.js",
+		        "start_line": 1,
+		        "end_line": 1,
+		        "start_column": 5,
+		        "end_column": 8
+		      },
+		      "classifications": []
+		    },
+		    "instances_url": "https://api.github.com/repos/octo-org/hello-world/code-scanning/alerts/10/instances"
+		  },
+		  "ref": "refs/heads/main",
+		  "commit_oid": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "code_scanning_alert.closed_by_user",
+		event:      "code_scanning_alert",
+		action:     "closed_by_user",
+		triggerLog: `{"type":"code_scanning_alert","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "closed_by_user",
+		  "alert": {
+		    "number": 10,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/code-scanning/alerts/10",
+		    "html_url": "https://github.com/octo-org/hello-world/security/code-scanning/10",
+		    "state": "dismissed",
+		    "dismissed_by": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "dismissed_at": "2019-05-15T15:21:14Z",
+		    "dismissed_reason": "false positive",
+		    "rule": {
+		      "id": "js/unused-local-variable",
+		      "severity": "note",
+		      "description": "Unused variable, import, function or class",
+		      "name": "js/unused-local-variable",
+		      "tags": [
+		        "maintainability"
+		      ]
+		    },
+		    "tool": {
+		      "name": "CodeQL",
+		      "guid": null,
+		      "version": "2.4.0"
+		    },
+		    "most_recent_instance": {
+		      "ref": "refs/heads/main",
+		      "analysis_key": ".github/workflows/codeql.yml:analyze",
+		      "environment": "{}",
+		      "category": ".github/workflows/codeql.yml:analyze",
+		      "state": "open",
+		      "commit_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "message": {
+		        "text": "Unused variable foo."
+		      },
+		      "location": {
+		        "path": "index.js",
+		        "start_line": 1,
+		        "end_line": 1,
+		        "start_column": 5,
+		        "end_column": 8
+		      },
+		      "classifications": []
+		    },
+		    "instances_url": "https://api.github.com/repos/octo-org/hello-world/code-scanning/alerts/10/instances"
+		  },
+		  "ref": "refs/heads/main",
+		  "commit_oid": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "commit_comment.created",
+		event:      "commit_comment",
+		action:     "created",
+		triggerLog: `{"type":"commit_comment","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "comment": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/comments/33548674",
+		    "html_url": "https://github.com/octo-org/hello-world/commit/ec26c3e57ca3a959ca5aad62de7213c562f8c821#commitcomment-33548674",
+		    "id": 33548674,
+		    "node_id": "MDEzOkNvbW1pdENvbW1lbnQzMzU0ODY3NA==",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "position": null,
+		    "line": null,
+		    "path": null,
+		    "commit_id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "author_association": "OWNER",
+		    "body": "This is a really good change! :+1:"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "create",
+		event:      "create",
+		action:     "",
+		triggerLog: `{"type":"create","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "ref": "simple-tag",
+		  "ref_type": "tag",
+		  "master_branch": "main",
+		  "description": null,
+		  "pusher_type": "user",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "delete",
+		event:      "delete",
+		action:     "",
+		triggerLog: `{"type":"delete","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "ref": "simple-tag",
+		  "ref_type": "tag",
+		  "pusher_type": "user",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "deploy_key.created",
+		event:      "deploy_key",
+		action:     "created",
+		triggerLog: `{"type":"deploy_key"}`,
+		payload: `{
+		  "action": "created",
+		  "key": {
+		    "id": 100,
+		    "key": "ssh-rsa AAAA...",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/keys/100",
+		    "title": "deploy",
+		    "verified": true,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "read_only": true
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "deploy_key.deleted",
+		event:      "deploy_key",
+		action:     "deleted",
+		triggerLog: `{"type":"deploy_key"}`,
+		payload: `{
+		  "action": "deleted",
+		  "key": {
+		    "id": 100,
+		    "key": "ssh-rsa AAAA...",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/keys/100",
+		    "title": "deploy",
+		    "verified": true,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "read_only": true
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "deployment.created",
+		event:      "deployment",
+		action:     "created",
+		triggerLog: `{"type":"deployment","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "deployment": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/deployments/145988746",
+		    "id": 145988746,
+		    "node_id": "MDEwOkRlcGxveW1lbnQxNDU5ODg3NDY=",
+		    "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "ref": "main",
+		    "task": "deploy",
+		    "payload": {},
+		    "environment": "production",
+		    "description": null,
+		    "creator": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "deployment_status.created",
+		event:      "deployment_status",
+		action:     "created",
+		triggerLog: `{"type":"deployment_status","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "deployment_status": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/deployments/145988746/statuses/209916254",
+		    "id": 209916254,
+		    "node_id": "MDE2OkRlcGxveW1lbnRTdGF0dXMyMDk5MTYyNTQ=",
+		    "state": "success",
+		    "creator": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "description": "",
+		    "environment": "production",
+		    "target_url": "",
+		    "created_at": "2019-05-15T15:21:14Z",
+		    "updated_at": "2019-05-15T15:21:14Z"
+		  },
+		  "deployment": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/deployments/145988746",
+		    "id": 145988746,
+		    "node_id": "MDEwOkRlcGxveW1lbnQxNDU5ODg3NDY=",
+		    "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "ref": "main",
+		    "task": "deploy",
+		    "payload": {},
+		    "environment": "production",
+		    "description": null,
+		    "creator": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "discussion.created",
+		event:      "discussion",
+		action:     "created",
+		triggerLog: `{"type":"discussion","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "discussion": {
+		    "repository_url": "https://api.github.com/repos/octo-org/hello-world",
+		    "category": {
+		      "id": 55,
+		      "repository_id": 186853002,
+		      "emoji": ":speech_balloon:",
+		      "name": "General",
+		      "description": "Chat about anything and everything here",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z",
+		      "slug": "general",
+		      "is_answerable": false
+		    },
+		    "answer_html_url": null,
+		    "answer_chosen_at": null,
+		    "answer_chosen_by": null,
+		    "html_url": "https://github.com/octo-org/hello-world/discussions/90",
+		    "id": 1,
+		    "node_id": "MDEwOkRpc2N1c3Npb24x",
+		    "number": 90,
+		    "title": "Welcome to discussions!",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "state": "open",
+		    "locked": false,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "author_association": "OWNER",
+		    "active_lock_reason": null,
+		    "body": "We're glad to have you here!"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "discussion.answered",
+		event:      "discussion",
+		action:     "answered",
+		triggerLog: `{"type":"discussion","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "answered",
+		  "discussion": {
+		    "repository_url": "https://api.github.com/repos/octo-org/hello-world",
+		    "category": {
+		      "id": 55,
+		      "repository_id": 186853002,
+		      "emoji": ":speech_balloon:",
+		      "name": "General",
+		      "description": "Chat about anything and everything here",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z",
+		      "slug": "general",
+		      "is_answerable": false
+		    },
+		    "answer_html_url": "https://github.com/octo-org/hello-world/discussions/90#discussioncomment-544078",
+		    "answer_chosen_at": "2019-05-15T15:21:14Z",
+		    "answer_chosen_by": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world/discussions/90",
+		    "id": 1,
+		    "node_id": "MDEwOkRpc2N1c3Npb24x",
+		    "number": 90,
+		    "title": "Welcome to discussions!",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "state": "open",
+		    "locked": false,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "author_association": "OWNER",
+		    "active_lock_reason": null,
+		    "body": "We're glad to have you here!"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "discussion.closed",
+		event:      "discussion",
+		action:     "closed",
+		triggerLog: `{"type":"discussion","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "closed",
+		  "discussion": {
+		    "repository_url": "https://api.github.com/repos/octo-org/hello-world",
+		    "category": {
+		      "id": 55,
+		      "repository_id": 186853002,
+		      "emoji": ":speech_balloon:",
+		      "name": "General",
+		      "description": "Chat about anything and everything here",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z",
+		      "slug": "general",
+		      "is_answerable": false
+		    },
+		    "answer_html_url": null,
+		    "answer_chosen_at": null,
+		    "answer_chosen_by": null,
+		    "html_url": "https://github.com/octo-org/hello-world/discussions/90",
+		    "id": 1,
+		    "node_id": "MDEwOkRpc2N1c3Npb24x",
+		    "number": 90,
+		    "title": "Welcome to discussions!",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "state": "closed",
+		    "locked": false,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "author_association": "OWNER",
+		    "active_lock_reason": null,
+		    "body": "We're glad to have you here!"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "discussion_comment.created",
+		event:      "discussion_comment",
+		action:     "created",
+		triggerLog: `{"type":"discussion_comment","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "comment": {
+		    "id": 544078,
+		    "node_id": "MDE3OkRpc2N1c3Npb25Db21tZW50NTQ0MDc4",
+		    "html_url": "https://github.com/octo-org/hello-world/discussions/90#discussioncomment-544078",
+		    "parent_id": null,
+		    "child_comment_count": 0,
+		    "repository_url": "octo-org/hello-world",
+		    "discussion_id": 1,
+		    "author_association": "OWNER",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:21:14Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "body": "I have so many questions to ask you!"
+		  },
+		  "discussion": {
+		    "repository_url": "https://api.github.com/repos/octo-org/hello-world",
+		    "category": {
+		      "id": 55,
+		      "repository_id": 186853002,
+		      "emoji": ":speech_balloon:",
+		      "name": "General",
+		      "description": "Chat about anything and everything here",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z",
+		      "slug": "general",
+		      "is_answerable": false
+		    },
+		    "answer_html_url": null,
+		    "answer_chosen_at": null,
+		    "answer_chosen_by": null,
+		    "html_url": "https://github.com/octo-org/hello-world/discussions/90",
+		    "id": 1,
+		    "node_id": "MDEwOkRpc2N1c3Npb24x",
+		    "number": 90,
+		    "title": "Welcome to discussions!",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "state": "open",
+		    "locked": false,
+		    "comments": 1,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "author_association": "OWNER",
+		    "active_lock_reason": null,
+		    "body": "We're glad to have you here!"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "discussion_comment.edited",
+		event:      "discussion_comment",
+		action:     "edited",
+		triggerLog: `{"type":"discussion_comment","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "edited",
+		  "comment": {
+		    "id": 544078,
+		    "node_id": "MDE3OkRpc2N1c3Npb25Db21tZW50NTQ0MDc4",
+		    "html_url": "https://github.com/octo-org/hello-world/discussions/90#discussioncomment-544078",
+		    "parent_id": null,
+		    "child_comment_count": 0,
+		    "repository_url": "octo-org/hello-world",
+		    "discussion_id": 1,
+		    "author_association": "OWNER",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:21:14Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "body": "I have so many questions to ask you!"
+		  },
+		  "discussion": {
+		    "repository_url": "https://api.github.com/repos/octo-org/hello-world",
+		    "category": {
+		      "id": 55,
+		      "repository_id": 186853002,
+		      "emoji": ":speech_balloon:",
+		      "name": "General",
+		      "description": "Chat about anything and everything here",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z",
+		      "slug": "general",
+		      "is_answerable": false
+		    },
+		    "answer_html_url": null,
+		    "answer_chosen_at": null,
+		    "answer_chosen_by": null,
+		    "html_url": "https://github.com/octo-org/hello-world/discussions/90",
+		    "id": 1,
+		    "node_id": "MDEwOkRpc2N1c3Npb24x",
+		    "number": 90,
+		    "title": "Welcome to discussions!",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "state": "open",
+		    "locked": false,
+		    "comments": 1,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "author_association": "OWNER",
+		    "active_lock_reason": null,
+		    "body": "We're glad to have you here!"
+		  },
+		  "changes": {
+		    "body": {
+		      "from": "I have a question"
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "discussion_comment.deleted",
+		event:      "discussion_comment",
+		action:     "deleted",
+		triggerLog: `{"type":"discussion_comment","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "deleted",
+		  "comment": {
+		    "id": 544078,
+		    "node_id": "MDE3OkRpc2N1c3Npb25Db21tZW50NTQ0MDc4",
+		    "html_url": "https://github.com/octo-org/hello-world/discussions/90#discussioncomment-544078",
+		    "parent_id": null,
+		    "child_comment_count": 0,
+		    "repository_url": "octo-org/hello-world",
+		    "discussion_id": 1,
+		    "author_association": "OWNER",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:21:14Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "body": "I have so many questions to ask you!"
+		  },
+		  "discussion": {
+		    "repository_url": "https://api.github.com/repos/octo-org/hello-world",
+		    "category": {
+		      "id": 55,
+		      "repository_id": 186853002,
+		      "emoji": ":speech_balloon:",
+		      "name": "General",
+		      "description": "Chat about anything and everything here",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z",
+		      "slug": "general",
+		      "is_answerable": false
+		    },
+		    "answer_html_url": null,
+		    "answer_chosen_at": null,
+		    "answer_chosen_by": null,
+		    "html_url": "https://github.com/octo-org/hello-world/discussions/90",
+		    "id": 1,
+		    "node_id": "MDEwOkRpc2N1c3Npb24x",
+		    "number": 90,
+		    "title": "Welcome to discussions!",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "state": "open",
+		    "locked": false,
+		    "comments": 1,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "author_association": "OWNER",
+		    "active_lock_reason": null,
+		    "body": "We're glad to have you here!"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "fork",
+		event:      "fork",
+		action:     "",
+		triggerLog: `{"type":"fork","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "forkee": {
+		    "id": 186853261,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMyNjE=",
+		    "name": "hello-world",
+		    "full_name": "octocat/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "html_url": "https://github.com/octocat/hello-world",
+		    "description": null,
+		    "fork": true,
+		    "url": "https://api.github.com/repos/octocat/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public",
+		    "public": true
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "github_app_authorization.revoked",
+		event:      "github_app_authorization",
+		action:     "revoked",
+		triggerLog: `{"type":"github_app_authorization","sender":"octocat"}`,
+		payload: `{
+		  "action": "revoked",
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  }
+		}`,
+	},
+	{
+		name:       "gollum",
+		event:      "gollum",
+		action:     "",
+		triggerLog: `{"type":"gollum","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "pages": [
+		    {
+		      "page_name": "Home",
+		      "title": "Home",
+		      "summary": null,
+		      "action": "created",
+		      "sha": "91ea1bd42aa2ba166b86e8aefe049e9837214e67",
+		      "html_url": "https://github.com/octo-org/hello-world/wiki/Home"
+		    }
+		  ],
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "installation.created",
+		event:      "installation",
+		action:     "created",
+		triggerLog: `{"type":"installation","sender":"octocat"}`,
+		payload: `{
+		  "action": "created",
+		  "installation": {
+		    "id": 2311213,
+		    "account": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "repository_selection": "selected",
+		    "access_tokens_url": "https://api.github.com/app/installations/2311213/access_tokens",
+		    "repositories_url": "https://api.github.com/installation/repositories",
+		    "html_url": "https://github.com/organizations/octo-org/settings/installations/2311213",
+		    "app_id": 29310,
+		    "target_id": 6811672,
+		    "target_type": "Organization",
+		    "permissions": {
+		      "metadata": "read",
+		      "issues": "write",
+		      "pull_requests": "write"
+		    },
+		    "events": [
+		      "issues",
+		      "issue_comment",
+		      "pull_request"
+		    ],
+		    "created_at": 1557933591,
+		    "updated_at": 1557933591,
+		    "single_file_name": null
+		  },
+		  "repositories": [
+		    {
+		      "id": 186853002,
+		      "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		      "name": "hello-world",
+		      "full_name": "octo-org/hello-world",
+		      "private": false
+		    }
+		  ],
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  }
+		}`,
+	},
+	{
+		name:       "installation.deleted",
+		event:      "installation",
+		action:     "deleted",
+		triggerLog: `{"type":"installation","sender":"octocat"}`,
+		payload: `{
+		  "action": "deleted",
+		  "installation": {
+		    "id": 2311213,
+		    "account": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "repository_selection": "selected",
+		    "access_tokens_url": "https://api.github.com/app/installations/2311213/access_tokens",
+		    "repositories_url": "https://api.github.com/installation/repositories",
+		    "html_url": "https://github.com/organizations/octo-org/settings/installations/2311213",
+		    "app_id": 29310,
+		    "target_id": 6811672,
+		    "target_type": "Organization",
+		    "permissions": {
+		      "metadata": "read",
+		      "issues": "write",
+		      "pull_requests": "write"
+		    },
+		    "events": [
+		      "issues",
+		      "issue_comment",
+		      "pull_request"
+		    ],
+		    "created_at": 1557933591,
+		    "updated_at": 1557933591,
+		    "single_file_name": null
+		  },
+		  "repositories": [
+		    {
+		      "id": 186853002,
+		      "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		      "name": "hello-world",
+		      "full_name": "octo-org/hello-world",
+		      "private": false
+		    }
+		  ],
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  }
+		}`,
+	},
+	{
+		name:       "installation.suspend",
+		event:      "installation",
+		action:     "suspend",
+		triggerLog: `{"type":"installation","sender":"octocat"}`,
+		payload: `{
+		  "action": "suspend",
+		  "installation": {
+		    "id": 2311213,
+		    "account": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "repository_selection": "selected",
+		    "access_tokens_url": "https://api.github.com/app/installations/2311213/access_tokens",
+		    "repositories_url": "https://api.github.com/installation/repositories",
+		    "html_url": "https://github.com/organizations/octo-org/settings/installations/2311213",
+		    "app_id": 29310,
+		    "target_id": 6811672,
+		    "target_type": "Organization",
+		    "permissions": {
+		      "metadata": "read",
+		      "issues": "write",
+		      "pull_requests": "write"
+		    },
+		    "events": [
+		      "issues",
+		      "issue_comment",
+		      "pull_request"
+		    ],
+		    "created_at": 1557933591,
+		    "updated_at": 1557933591,
+		    "single_file_name": null,
+		    "suspended_by": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "suspended_at": "2019-05-15T15:21:14Z"
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  }
+		}`,
+	},
+	{
+		name:       "installation.unsuspend",
+		event:      "installation",
+		action:     "unsuspend",
+		triggerLog: `{"type":"installation","sender":"octocat"}`,
+		payload: `{
+		  "action": "unsuspend",
+		  "installation": {
+		    "id": 2311213,
+		    "account": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "repository_selection": "selected",
+		    "access_tokens_url": "https://api.github.com/app/installations/2311213/access_tokens",
+		    "repositories_url": "https://api.github.com/installation/repositories",
+		    "html_url": "https://github.com/organizations/octo-org/settings/installations/2311213",
+		    "app_id": 29310,
+		    "target_id": 6811672,
+		    "target_type": "Organization",
+		    "permissions": {
+		      "metadata": "read",
+		      "issues": "write",
+		      "pull_requests": "write"
+		    },
+		    "events": [
+		      "issues",
+		      "issue_comment",
+		      "pull_request"
+		    ],
+		    "created_at": 1557933591,
+		    "updated_at": 1557933591,
+		    "single_file_name": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  }
+		}`,
+	},
+	{
+		name:       "installation_repositories.added",
+		event:      "installation_repositories",
+		action:     "added",
+		triggerLog: `{"type":"installation_repositories","sender":"octocat"}`,
+		payload: `{
+		  "action": "added",
+		  "installation": {
+		    "id": 2311213,
+		    "account": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "repository_selection": "selected",
+		    "access_tokens_url": "https://api.github.com/app/installations/2311213/access_tokens",
+		    "repositories_url": "https://api.github.com/installation/repositories",
+		    "html_url": "https://github.com/organizations/octo-org/settings/installations/2311213",
+		    "app_id": 29310,
+		    "target_id": 6811672,
+		    "target_type": "Organization",
+		    "permissions": {
+		      "metadata": "read",
+		      "issues": "write",
+		      "pull_requests": "write"
+		    },
+		    "events": [
+		      "issues",
+		      "issue_comment",
+		      "pull_request"
+		    ],
+		    "created_at": 1557933591,
+		    "updated_at": 1557933591,
+		    "single_file_name": null
+		  },
+		  "repository_selection": "selected",
+		  "repositories_added": [
+		    {
+		      "id": 186853007,
+		      "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDc=",
+		      "name": "octo-docs",
+		      "full_name": "octo-org/octo-docs",
+		      "private": false
+		    }
+		  ],
+		  "repositories_removed": [],
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  }
+		}`,
+	},
+	{
+		name:       "installation_repositories.removed",
+		event:      "installation_repositories",
+		action:     "removed",
+		triggerLog: `{"type":"installation_repositories","sender":"octocat"}`,
+		payload: `{
+		  "action": "removed",
+		  "installation": {
+		    "id": 2311213,
+		    "account": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "repository_selection": "selected",
+		    "access_tokens_url": "https://api.github.com/app/installations/2311213/access_tokens",
+		    "repositories_url": "https://api.github.com/installation/repositories",
+		    "html_url": "https://github.com/organizations/octo-org/settings/installations/2311213",
+		    "app_id": 29310,
+		    "target_id": 6811672,
+		    "target_type": "Organization",
+		    "permissions": {
+		      "metadata": "read",
+		      "issues": "write",
+		      "pull_requests": "write"
+		    },
+		    "events": [
+		      "issues",
+		      "issue_comment",
+		      "pull_request"
+		    ],
+		    "created_at": 1557933591,
+		    "updated_at": 1557933591,
+		    "single_file_name": null
+		  },
+		  "repository_selection": "selected",
+		  "repositories_added": [],
+		  "repositories_removed": [
+		    {
+		      "id": 186853007,
+		      "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDc=",
+		      "name": "octo-docs",
+		      "full_name": "octo-org/octo-docs",
+		      "private": false
+		    }
+		  ],
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  }
+		}`,
+	},
+	{
+		name:       "issue_comment.created",
+		event:      "issue_comment",
+		action:     "created",
+		triggerLog: `{"type":"issue_comment","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "issue": {
+		    "id": 444500041,
+		    "node_id": "MDU6SXNzdWU0NDQ1MDAwNDE=",
+		    "number": 1,
+		    "title": "Spelling error in the README file",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "labels": [],
+		    "state": "open",
+		    "locked": false,
+		    "assignee": null,
+		    "assignees": [],
+		    "milestone": null,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "closed_at": null,
+		    "author_association": "OWNER",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1",
+		    "body": "It looks like you accidently spelled 'commit' with two 't's."
+		  },
+		  "comment": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/issues/comments/492700400",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1#issuecomment-492700400",
+		    "id": 492700400,
+		    "node_id": "MDEyOklzc3VlQ29tbWVudDQ5MjcwMDQwMA==",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:21:14Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "author_association": "OWNER",
+		    "body": "You are totally right! I'll get this fixed right away."
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "issue_comment.edited",
+		event:      "issue_comment",
+		action:     "edited",
+		triggerLog: `{"type":"issue_comment","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "edited",
+		  "issue": {
+		    "id": 444500041,
+		    "node_id": "MDU6SXNzdWU0NDQ1MDAwNDE=",
+		    "number": 1,
+		    "title": "Spelling error in the README file",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "labels": [],
+		    "state": "open",
+		    "locked": false,
+		    "assignee": null,
+		    "assignees": [],
+		    "milestone": null,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "closed_at": null,
+		    "author_association": "OWNER",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1",
+		    "body": "It looks like you accidently spelled 'commit' with two 't's."
+		  },
+		  "comment": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/issues/comments/492700400",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1#issuecomment-492700400",
+		    "id": 492700400,
+		    "node_id": "MDEyOklzc3VlQ29tbWVudDQ5MjcwMDQwMA==",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:21:14Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "author_association": "OWNER",
+		    "body": "You are totally right! I'll get this fixed right away."
+		  },
+		  "changes": {
+		    "body": {
+		      "from": "You are right!"
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "issue_comment.deleted",
+		event:      "issue_comment",
+		action:     "deleted",
+		triggerLog: `{"type":"issue_comment","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "deleted",
+		  "issue": {
+		    "id": 444500041,
+		    "node_id": "MDU6SXNzdWU0NDQ1MDAwNDE=",
+		    "number": 1,
+		    "title": "Spelling error in the README file",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "labels": [],
+		    "state": "open",
+		    "locked": false,
+		    "assignee": null,
+		    "assignees": [],
+		    "milestone": null,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "closed_at": null,
+		    "author_association": "OWNER",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1",
+		    "body": "It looks like you accidently spelled 'commit' with two 't's."
+		  },
+		  "comment": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/issues/comments/492700400",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1#issuecomment-492700400",
+		    "id": 492700400,
+		    "node_id": "MDEyOklzc3VlQ29tbWVudDQ5MjcwMDQwMA==",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:21:14Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "author_association": "OWNER",
+		    "body": "You are totally right! I'll get this fixed right away."
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "issue_comment.created.pull_request",
+		event:      "issue_comment",
+		action:     "created",
+		triggerLog: `{"type":"issue_comment","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "issue": {
+		    "id": 444500041,
+		    "node_id": "MDU6SXNzdWU0NDQ1MDAwNDE=",
+		    "number": 2,
+		    "title": "Spelling error in the README file",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "labels": [],
+		    "state": "open",
+		    "locked": false,
+		    "assignee": null,
+		    "assignees": [],
+		    "milestone": null,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "closed_at": null,
+		    "author_association": "OWNER",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2",
+		    "body": "It looks like you accidently spelled 'commit' with two 't's.",
+		    "pull_request": {
+		      "url": "https://api.github.com/repos/octo-org/hello-world/pulls/2",
+		      "html_url": "https://github.com/octo-org/hello-world/pull/2"
+		    }
+		  },
+		  "comment": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/issues/comments/492700400",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2#issuecomment-492700401",
+		    "id": 492700401,
+		    "node_id": "MDEyOklzc3VlQ29tbWVudDQ5MjcwMDQwMA==",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:21:14Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "author_association": "OWNER",
+		    "body": "/lgtm"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "issues.opened",
+		event:      "issues",
+		action:     "opened",
+		triggerLog: `{"type":"issues","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "opened",
+		  "issue": {
+		    "id": 444500041,
+		    "node_id": "MDU6SXNzdWU0NDQ1MDAwNDE=",
+		    "number": 1,
+		    "title": "Spelling error in the README file",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "labels": [],
+		    "state": "open",
+		    "locked": false,
+		    "assignee": null,
+		    "assignees": [],
+		    "milestone": null,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "closed_at": null,
+		    "author_association": "OWNER",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1",
+		    "body": "It looks like you accidently spelled 'commit' with two 't's."
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "issues.closed",
+		event:      "issues",
+		action:     "closed",
+		triggerLog: `{"type":"issues","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "closed",
+		  "issue": {
+		    "id": 444500041,
+		    "node_id": "MDU6SXNzdWU0NDQ1MDAwNDE=",
+		    "number": 1,
+		    "title": "Spelling error in the README file",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "labels": [],
+		    "state": "closed",
+		    "locked": false,
+		    "assignee": null,
+		    "assignees": [],
+		    "milestone": null,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "closed_at": "2019-05-15T15:21:14Z",
+		    "author_association": "OWNER",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1",
+		    "body": "It looks like you accidently spelled 'commit' with two 't's."
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "issues.reopened",
+		event:      "issues",
+		action:     "reopened",
+		triggerLog: `{"type":"issues","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "reopened",
+		  "issue": {
+		    "id": 444500041,
+		    "node_id": "MDU6SXNzdWU0NDQ1MDAwNDE=",
+		    "number": 1,
+		    "title": "Spelling error in the README file",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "labels": [],
+		    "state": "open",
+		    "locked": false,
+		    "assignee": null,
+		    "assignees": [],
+		    "milestone": null,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "closed_at": null,
+		    "author_association": "OWNER",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1",
+		    "body": "It looks like you accidently spelled 'commit' with two 't's."
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "issues.labeled",
+		event:      "issues",
+		action:     "labeled",
+		triggerLog: `{"type":"issues","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "labeled",
+		  "issue": {
+		    "id": 444500041,
+		    "node_id": "MDU6SXNzdWU0NDQ1MDAwNDE=",
+		    "number": 1,
+		    "title": "Spelling error in the README file",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "labels": [
+		      {
+		        "id": 1362934389,
+		        "node_id": "MDU6TGFiZWwxMzYyOTM0Mzg5",
+		        "url": "https://api.github.com/repos/octo-org/hello-world/labels/bug",
+		        "name": "bug",
+		        "color": "d73a4a",
+		        "default": true,
+		        "description": "Something isn't working"
+		      }
+		    ],
+		    "state": "open",
+		    "locked": false,
+		    "assignee": null,
+		    "assignees": [],
+		    "milestone": null,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "closed_at": null,
+		    "author_association": "OWNER",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1",
+		    "body": "It looks like you accidently spelled 'commit' with two 't's."
+		  },
+		  "label": {
+		    "id": 1362934389,
+		    "node_id": "MDU6TGFiZWwxMzYyOTM0Mzg5",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/labels/bug",
+		    "name": "bug",
+		    "color": "d73a4a",
+		    "default": true,
+		    "description": "Something isn't working"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "issues.edited",
+		event:      "issues",
+		action:     "edited",
+		triggerLog: `{"type":"issues","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "edited",
+		  "issue": {
+		    "id": 444500041,
+		    "node_id": "MDU6SXNzdWU0NDQ1MDAwNDE=",
+		    "number": 1,
+		    "title": "Spelling error in the README file",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "labels": [],
+		    "state": "open",
+		    "locked": false,
+		    "assignee": null,
+		    "assignees": [],
+		    "milestone": null,
+		    "comments": 0,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "closed_at": null,
+		    "author_association": "OWNER",
+		    "html_url": "https://github.com/octo-org/hello-world/issues/1",
+		    "body": "It looks like you accidently spelled 'commit' with two 't's."
+		  },
+		  "changes": {
+		    "title": {
+		      "from": "Spelling error"
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "label.created",
+		event:      "label",
+		action:     "created",
+		triggerLog: `{"type":"label","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "label": {
+		    "id": 1362934389,
+		    "node_id": "MDU6TGFiZWwxMzYyOTM0Mzg5",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/labels/bug",
+		    "name": "bug",
+		    "color": "d73a4a",
+		    "default": true,
+		    "description": "Something isn't working"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "label.edited",
+		event:      "label",
+		action:     "edited",
+		triggerLog: `{"type":"label","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "edited",
+		  "label": {
+		    "id": 1362934389,
+		    "node_id": "MDU6TGFiZWwxMzYyOTM0Mzg5",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/labels/bug",
+		    "name": "bug",
+		    "color": "d73a4a",
+		    "default": true,
+		    "description": "Something isn't working"
+		  },
+		  "changes": {
+		    "color": {
+		      "from": "ee0701"
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "label.deleted",
+		event:      "label",
+		action:     "deleted",
+		triggerLog: `{"type":"label","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "deleted",
+		  "label": {
+		    "id": 1362934389,
+		    "node_id": "MDU6TGFiZWwxMzYyOTM0Mzg5",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/labels/bug",
+		    "name": "bug",
+		    "color": "d73a4a",
+		    "default": true,
+		    "description": "Something isn't working"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "marketplace_purchase.purchased",
+		event:      "marketplace_purchase",
+		action:     "purchased",
+		triggerLog: `{"type":"marketplace_purchase","sender":"octocat"}`,
+		payload: `{
+		  "action": "purchased",
+		  "effective_date": "2019-05-15T00:00:00Z",
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "marketplace_purchase": {
+		    "account": {
+		      "type": "Organization",
+		      "id": 6811672,
+		      "login": "octo-org",
+		      "organization_billing_email": "billing@example.com"
+		    },
+		    "billing_cycle": "monthly",
+		    "unit_count": 1,
+		    "on_free_trial": false,
+		    "free_trial_ends_on": null,
+		    "next_billing_date": "2019-06-15T00:00:00Z",
+		    "plan": {
+		      "id": 435,
+		      "name": "Basic",
+		      "description": "Basic plan",
+		      "monthly_price_in_cents": 1000,
+		      "yearly_price_in_cents": 10000,
+		      "price_model": "per-unit",
+		      "has_free_trial": true,
+		      "unit_name": "seat",
+		      "bullets": []
+		    }
+		  }
+		}`,
+	},
+	{
+		name:       "marketplace_purchase.cancelled",
+		event:      "marketplace_purchase",
+		action:     "cancelled",
+		triggerLog: `{"type":"marketplace_purchase","sender":"octocat"}`,
+		payload: `{
+		  "action": "cancelled",
+		  "effective_date": "2019-05-15T00:00:00Z",
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "marketplace_purchase": {
+		    "account": {
+		      "type": "Organization",
+		      "id": 6811672,
+		      "login": "octo-org",
+		      "organization_billing_email": "billing@example.com"
+		    },
+		    "billing_cycle": "monthly",
+		    "unit_count": 1,
+		    "on_free_trial": false,
+		    "free_trial_ends_on": null,
+		    "next_billing_date": "2019-06-15T00:00:00Z",
+		    "plan": {
+		      "id": 435,
+		      "name": "Basic",
+		      "description": "Basic plan",
+		      "monthly_price_in_cents": 1000,
+		      "yearly_price_in_cents": 10000,
+		      "price_model": "per-unit",
+		      "has_free_trial": true,
+		      "unit_name": "seat",
+		      "bullets": []
+		    }
+		  }
+		}`,
+	},
+	{
+		name:       "member.added",
+		event:      "member",
+		action:     "added",
+		triggerLog: `{"type":"member","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "added",
+		  "member": {
+		    "login": "octocat-2",
+		    "id": 21031067,
+		    "node_id": "MDQ6VXNlcj21031067",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat-2",
+		    "url": "https://api.github.com/users/octocat-2"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "member.removed",
+		event:      "member",
+		action:     "removed",
+		triggerLog: `{"type":"member","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "removed",
+		  "member": {
+		    "login": "octocat-2",
+		    "id": 21031067,
+		    "node_id": "MDQ6VXNlcj21031067",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat-2",
+		    "url": "https://api.github.com/users/octocat-2"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "membership.added",
+		event:      "membership",
+		action:     "added",
+		triggerLog: `{"type":"membership","sender":"octocat","org":"octo-org"}`,
+		payload: `{
+		  "action": "added",
+		  "scope": "team",
+		  "member": {
+		    "login": "octocat-2",
+		    "id": 21031067,
+		    "node_id": "MDQ6VXNlcj21031067",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat-2",
+		    "url": "https://api.github.com/users/octocat-2"
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "team": {
+		    "name": "github",
+		    "id": 3253328,
+		    "node_id": "MDQ6VGVhbTMyNTMzMjg=",
+		    "slug": "github",
+		    "description": "Open-source team",
+		    "privacy": "secret",
+		    "url": "https://api.github.com/teams/3253328",
+		    "permission": "pull"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "membership.removed",
+		event:      "membership",
+		action:     "removed",
+		triggerLog: `{"type":"membership","sender":"octocat","org":"octo-org"}`,
+		payload: `{
+		  "action": "removed",
+		  "scope": "team",
+		  "member": {
+		    "login": "octocat-2",
+		    "id": 21031067,
+		    "node_id": "MDQ6VXNlcj21031067",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat-2",
+		    "url": "https://api.github.com/users/octocat-2"
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "team": {
+		    "name": "github",
+		    "id": 3253328,
+		    "node_id": "MDQ6VGVhbTMyNTMzMjg=",
+		    "slug": "github",
+		    "description": "Open-source team",
+		    "privacy": "secret",
+		    "url": "https://api.github.com/teams/3253328",
+		    "permission": "pull"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "merge_group.checks_requested",
+		event:      "merge_group",
+		action:     "checks_requested",
+		triggerLog: `{"type":"merge_group","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "checks_requested",
+		  "merge_group": {
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "head_ref": "refs/heads/gh-readonly-queue/main/pr-2-6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		    "base_sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		    "base_ref": "refs/heads/main",
+		    "head_commit": {
+		      "id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "tree_id": "f9d2a07e9488b91af2641b26b9407fe22a451433",
+		      "message": "Merge pull request #2",
+		      "timestamp": "2019-05-15T15:20:33Z"
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "merge_group.destroyed",
+		event:      "merge_group",
+		action:     "destroyed",
+		triggerLog: `{"type":"merge_group","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "destroyed",
+		  "merge_group": {
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "head_ref": "refs/heads/gh-readonly-queue/main/pr-2-6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		    "base_sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		    "base_ref": "refs/heads/main",
+		    "head_commit": {
+		      "id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "tree_id": "f9d2a07e9488b91af2641b26b9407fe22a451433",
+		      "message": "Merge pull request #2",
+		      "timestamp": "2019-05-15T15:20:33Z"
+		    }
+		  },
+		  "reason": "merged",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "meta.deleted",
+		event:      "meta",
+		action:     "deleted",
+		triggerLog: `{"type":"meta"}`,
+		payload: `{
+		  "action": "deleted",
+		  "hook_id": 109948940,
+		  "hook": {
+		    "type": "Repository",
+		    "id": 109948940,
+		    "name": "web",
+		    "active": true,
+		    "events": [
+		      "*"
+		    ],
+		    "config": {
+		      "content_type": "json",
+		      "url": "https://smee.io/****************",
+		      "insecure_ssl": "0"
+		    },
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "milestone.created",
+		event:      "milestone",
+		action:     "created",
+		triggerLog: `{"type":"milestone","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "milestone": {
+		    "id": 4317517,
+		    "number": 1,
+		    "title": "v1.0",
+		    "description": "Add new templates",
+		    "state": "open",
+		    "open_issues": 0,
+		    "closed_issues": 0,
+		    "creator": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world/milestone/1",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "due_on": "2019-05-23T07:00:00Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "milestone.closed",
+		event:      "milestone",
+		action:     "closed",
+		triggerLog: `{"type":"milestone","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "closed",
+		  "milestone": {
+		    "id": 4317517,
+		    "number": 1,
+		    "title": "v1.0",
+		    "description": "Add new templates",
+		    "state": "closed",
+		    "open_issues": 0,
+		    "closed_issues": 0,
+		    "creator": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world/milestone/1",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "due_on": "2019-05-23T07:00:00Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "org_block.blocked",
+		event:      "org_block",
+		action:     "blocked",
+		triggerLog: `{"type":"org_block","sender":"octocat"}`,
+		payload: `{
+		  "action": "blocked",
+		  "blocked_user": {
+		    "login": "spammer",
+		    "id": 39652351,
+		    "node_id": "MDQ6VXNlcj39652351",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/spammer",
+		    "url": "https://api.github.com/users/spammer"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "org_block.unblocked",
+		event:      "org_block",
+		action:     "unblocked",
+		triggerLog: `{"type":"org_block","sender":"octocat"}`,
+		payload: `{
+		  "action": "unblocked",
+		  "blocked_user": {
+		    "login": "spammer",
+		    "id": 39652351,
+		    "node_id": "MDQ6VXNlcj39652351",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/spammer",
+		    "url": "https://api.github.com/users/spammer"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "organization.member_added",
+		event:      "organization",
+		action:     "member_added",
+		triggerLog: `{"type":"organization","sender":"octocat"}`,
+		payload: `{
+		  "action": "member_added",
+		  "membership": {
+		    "url": "https://api.github.com/orgs/octo-org/memberships/octocat-2",
+		    "state": "active",
+		    "role": "member",
+		    "organization_url": "https://api.github.com/orgs/octo-org",
+		    "user": {
+		      "login": "octocat-2",
+		      "id": 21031067,
+		      "node_id": "MDQ6VXNlcj21031067",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat-2",
+		      "url": "https://api.github.com/users/octocat-2"
+		    }
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "organization.member_removed",
+		event:      "organization",
+		action:     "member_removed",
+		triggerLog: `{"type":"organization","sender":"octocat"}`,
+		payload: `{
+		  "action": "member_removed",
+		  "membership": {
+		    "url": "https://api.github.com/orgs/octo-org/memberships/octocat-2",
+		    "state": "active",
+		    "role": "member",
+		    "organization_url": "https://api.github.com/orgs/octo-org",
+		    "user": {
+		      "login": "octocat-2",
+		      "id": 21031067,
+		      "node_id": "MDQ6VXNlcj21031067",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat-2",
+		      "url": "https://api.github.com/users/octocat-2"
+		    }
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "package.published",
+		event:      "package",
+		action:     "published",
+		triggerLog: `{"type":"package","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "published",
+		  "package": {
+		    "id": 10696,
+		    "name": "hello-world-npm",
+		    "namespace": "octo-org",
+		    "description": null,
+		    "ecosystem": "npm",
+		    "package_type": "npm",
+		    "html_url": "https://github.com/octo-org/hello-world/packages/10696",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "package_version": {
+		      "id": 24147,
+		      "version": "1.0.0",
+		      "summary": null,
+		      "name": "1.0.0",
+		      "description": "",
+		      "html_url": "https://github.com/octo-org/hello-world/packages/10696?version=1.0.0",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z",
+		      "author": {
+		        "login": "octocat",
+		        "id": 583231,
+		        "node_id": "MDQ6VXNlcj583231",
+		        "type": "User",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octocat",
+		        "url": "https://api.github.com/users/octocat"
+		      }
+		    },
+		    "registry": {
+		      "about_url": "https://docs.github.com/packages",
+		      "name": "GitHub npm registry",
+		      "type": "npm",
+		      "url": "https://npm.pkg.github.com/octo-org",
+		      "vendor": "GitHub Inc"
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "package.updated",
+		event:      "package",
+		action:     "updated",
+		triggerLog: `{"type":"package","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "updated",
+		  "package": {
+		    "id": 10696,
+		    "name": "hello-world-npm",
+		    "namespace": "octo-org",
+		    "description": null,
+		    "ecosystem": "npm",
+		    "package_type": "npm",
+		    "html_url": "https://github.com/octo-org/hello-world/packages/10696",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "package_version": {
+		      "id": 24147,
+		      "version": "1.0.0",
+		      "summary": null,
+		      "name": "1.0.0",
+		      "description": "",
+		      "html_url": "https://github.com/octo-org/hello-world/packages/10696?version=1.0.0",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z",
+		      "author": {
+		        "login": "octocat",
+		        "id": 583231,
+		        "node_id": "MDQ6VXNlcj583231",
+		        "type": "User",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octocat",
+		        "url": "https://api.github.com/users/octocat"
+		      }
+		    },
+		    "registry": {
+		      "about_url": "https://docs.github.com/packages",
+		      "name": "GitHub npm registry",
+		      "type": "npm",
+		      "url": "https://npm.pkg.github.com/octo-org",
+		      "vendor": "GitHub Inc"
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "page_build",
+		event:      "page_build",
+		action:     "",
+		triggerLog: `{"type":"page_build","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "id": 130514899,
+		  "build": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/pages/builds/130514899",
+		    "status": "built",
+		    "error": {
+		      "message": null
+		    },
+		    "pusher": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "commit": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "duration": 2104,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "ping",
+		event:      "ping",
+		action:     "",
+		triggerLog: `{"type":"ping"}`,
+		payload: `{
+		  "zen": "Anything added dilutes everything else.",
+		  "hook_id": 109948940,
+		  "hook": {
+		    "type": "Repository",
+		    "id": 109948940,
+		    "name": "web",
+		    "active": true,
+		    "events": [
+		      "*"
+		    ],
+		    "config": {
+		      "content_type": "json",
+		      "url": "https://smee.io/****************",
+		      "insecure_ssl": "0"
+		    },
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "project.created",
+		event:      "project",
+		action:     "created",
+		triggerLog: `{"type":"project","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "project": {
+		    "owner_url": "https://api.github.com/repos/octo-org/hello-world",
+		    "html_url": "https://github.com/octo-org/hello-world/projects/1",
+		    "id": 2640902,
+		    "node_id": "MDc6UHJvamVjdDI2NDA5MDI=",
+		    "name": "Space 2.0",
+		    "body": "Project tasks for a trip to Space",
+		    "number": 1,
+		    "state": "open",
+		    "creator": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "project.closed",
+		event:      "project",
+		action:     "closed",
+		triggerLog: `{"type":"project","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "closed",
+		  "project": {
+		    "owner_url": "https://api.github.com/repos/octo-org/hello-world",
+		    "html_url": "https://github.com/octo-org/hello-world/projects/1",
+		    "id": 2640902,
+		    "node_id": "MDc6UHJvamVjdDI2NDA5MDI=",
+		    "name": "Space 2.0",
+		    "body": "Project tasks for a trip to Space",
+		    "number": 1,
+		    "state": "closed",
+		    "creator": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "project_card.created",
+		event:      "project_card",
+		action:     "created",
+		triggerLog: `{"type":"project_card","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "project_card": {
+		    "url": "https://api.github.com/projects/columns/cards/21567453",
+		    "column_url": "https://api.github.com/projects/columns/5368157",
+		    "column_id": 5368157,
+		    "id": 21567453,
+		    "node_id": "MDExOlByb2plY3RDYXJkMjE1Njc0NTM=",
+		    "note": "Work that can be completed in one hour or less.",
+		    "archived": false,
+		    "creator": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "project_card.moved",
+		event:      "project_card",
+		action:     "moved",
+		triggerLog: `{"type":"project_card","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "moved",
+		  "project_card": {
+		    "url": "https://api.github.com/projects/columns/cards/21567453",
+		    "column_url": "https://api.github.com/projects/columns/5368157",
+		    "column_id": 5368157,
+		    "id": 21567453,
+		    "node_id": "MDExOlByb2plY3RDYXJkMjE1Njc0NTM=",
+		    "note": "Work that can be completed in one hour or less.",
+		    "archived": false,
+		    "creator": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z"
+		  },
+		  "changes": {
+		    "column_id": {
+		      "from": 5368156
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "project_column.created",
+		event:      "project_column",
+		action:     "created",
+		triggerLog: `{"type":"project_column","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "project_column": {
+		    "id": 5368157,
+		    "node_id": "MDEzOlByb2plY3RDb2x1bW41MzY4MTU3",
+		    "name": "Small bugfixes",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "url": "https://api.github.com/projects/columns/5368157",
+		    "project_url": "https://api.github.com/projects/2640902"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "project_column.deleted",
+		event:      "project_column",
+		action:     "deleted",
+		triggerLog: `{"type":"project_column","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "deleted",
+		  "project_column": {
+		    "id": 5368157,
+		    "node_id": "MDEzOlByb2plY3RDb2x1bW41MzY4MTU3",
+		    "name": "Small bugfixes",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "url": "https://api.github.com/projects/columns/5368157",
+		    "project_url": "https://api.github.com/projects/2640902"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "public",
+		event:      "public",
+		action:     "",
+		triggerLog: `{"type":"public","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "pull_request.opened",
+		event:      "pull_request",
+		action:     "opened",
+		triggerLog: `{"type":"pull_request","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "opened",
+		  "number": 2,
+		  "pull_request": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/pulls/2",
+		    "id": 279147437,
+		    "node_id": "MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2",
+		    "number": 2,
+		    "state": "open",
+		    "locked": false,
+		    "title": "Update the README with new information.",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "This is a pretty simple change that we need to pull into main.",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "closed_at": null,
+		    "merged_at": null,
+		    "merge_commit_sha": null,
+		    "assignees": [],
+		    "requested_reviewers": [],
+		    "labels": [],
+		    "draft": false,
+		    "head": {
+		      "label": "octo-org:changes",
+		      "ref": "changes",
+		      "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "base": {
+		      "label": "octo-org:main",
+		      "ref": "main",
+		      "sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "author_association": "OWNER",
+		    "merged": false,
+		    "mergeable": null,
+		    "comments": 0,
+		    "review_comments": 0,
+		    "commits": 1,
+		    "additions": 1,
+		    "deletions": 1,
+		    "changed_files": 1
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "pull_request.closed",
+		event:      "pull_request",
+		action:     "closed",
+		triggerLog: `{"type":"pull_request","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "closed",
+		  "number": 2,
+		  "pull_request": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/pulls/2",
+		    "id": 279147437,
+		    "node_id": "MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2",
+		    "number": 2,
+		    "state": "closed",
+		    "locked": false,
+		    "title": "Update the README with new information.",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "This is a pretty simple change that we need to pull into main.",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "closed_at": "2019-05-15T15:21:14Z",
+		    "merged_at": "2019-05-15T15:21:14Z",
+		    "merge_commit_sha": "c4295bd74fb0f4fda03689c3df3f2803b658fd85",
+		    "assignees": [],
+		    "requested_reviewers": [],
+		    "labels": [],
+		    "draft": false,
+		    "head": {
+		      "label": "octo-org:changes",
+		      "ref": "changes",
+		      "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "base": {
+		      "label": "octo-org:main",
+		      "ref": "main",
+		      "sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "author_association": "OWNER",
+		    "merged": true,
+		    "mergeable": null,
+		    "comments": 0,
+		    "review_comments": 0,
+		    "commits": 1,
+		    "additions": 1,
+		    "deletions": 1,
+		    "changed_files": 1
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "pull_request.synchronize",
+		event:      "pull_request",
+		action:     "synchronize",
+		triggerLog: `{"type":"pull_request","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "synchronize",
+		  "number": 2,
+		  "pull_request": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/pulls/2",
+		    "id": 279147437,
+		    "node_id": "MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2",
+		    "number": 2,
+		    "state": "open",
+		    "locked": false,
+		    "title": "Update the README with new information.",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "This is a pretty simple change that we need to pull into main.",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "closed_at": null,
+		    "merged_at": null,
+		    "merge_commit_sha": null,
+		    "assignees": [],
+		    "requested_reviewers": [],
+		    "labels": [],
+		    "draft": false,
+		    "head": {
+		      "label": "octo-org:changes",
+		      "ref": "changes",
+		      "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "base": {
+		      "label": "octo-org:main",
+		      "ref": "main",
+		      "sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "author_association": "OWNER",
+		    "merged": false,
+		    "mergeable": null,
+		    "comments": 0,
+		    "review_comments": 0,
+		    "commits": 1,
+		    "additions": 1,
+		    "deletions": 1,
+		    "changed_files": 1
+		  },
+		  "before": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		  "after": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "pull_request.labeled",
+		event:      "pull_request",
+		action:     "labeled",
+		triggerLog: `{"type":"pull_request","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "labeled",
+		  "number": 2,
+		  "pull_request": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/pulls/2",
+		    "id": 279147437,
+		    "node_id": "MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2",
+		    "number": 2,
+		    "state": "open",
+		    "locked": false,
+		    "title": "Update the README with new information.",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "This is a pretty simple change that we need to pull into main.",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "closed_at": null,
+		    "merged_at": null,
+		    "merge_commit_sha": null,
+		    "assignees": [],
+		    "requested_reviewers": [],
+		    "labels": [
+		      {
+		        "id": 1362934389,
+		        "node_id": "MDU6TGFiZWwxMzYyOTM0Mzg5",
+		        "url": "https://api.github.com/repos/octo-org/hello-world/labels/bug",
+		        "name": "bug",
+		        "color": "d73a4a",
+		        "default": true,
+		        "description": "Something isn't working"
+		      }
+		    ],
+		    "draft": false,
+		    "head": {
+		      "label": "octo-org:changes",
+		      "ref": "changes",
+		      "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "base": {
+		      "label": "octo-org:main",
+		      "ref": "main",
+		      "sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "author_association": "OWNER",
+		    "merged": false,
+		    "mergeable": null,
+		    "comments": 0,
+		    "review_comments": 0,
+		    "commits": 1,
+		    "additions": 1,
+		    "deletions": 1,
+		    "changed_files": 1
+		  },
+		  "label": {
+		    "id": 1362934389,
+		    "node_id": "MDU6TGFiZWwxMzYyOTM0Mzg5",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/labels/bug",
+		    "name": "bug",
+		    "color": "d73a4a",
+		    "default": true,
+		    "description": "Something isn't working"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "pull_request.ready_for_review",
+		event:      "pull_request",
+		action:     "ready_for_review",
+		triggerLog: `{"type":"pull_request","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "ready_for_review",
+		  "number": 2,
+		  "pull_request": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/pulls/2",
+		    "id": 279147437,
+		    "node_id": "MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2",
+		    "number": 2,
+		    "state": "open",
+		    "locked": false,
+		    "title": "Update the README with new information.",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "This is a pretty simple change that we need to pull into main.",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "closed_at": null,
+		    "merged_at": null,
+		    "merge_commit_sha": null,
+		    "assignees": [],
+		    "requested_reviewers": [],
+		    "labels": [],
+		    "draft": false,
+		    "head": {
+		      "label": "octo-org:changes",
+		      "ref": "changes",
+		      "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "base": {
+		      "label": "octo-org:main",
+		      "ref": "main",
+		      "sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "author_association": "OWNER",
+		    "merged": false,
+		    "mergeable": null,
+		    "comments": 0,
+		    "review_comments": 0,
+		    "commits": 1,
+		    "additions": 1,
+		    "deletions": 1,
+		    "changed_files": 1
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "pull_request_review.submitted",
+		event:      "pull_request_review",
+		action:     "submitted",
+		triggerLog: `{"type":"pull_request_review","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "submitted",
+		  "review": {
+		    "id": 237895671,
+		    "node_id": "MDE3OlB1bGxSZXF1ZXN0UmV2aWV3MjM3ODk1Njcx",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": null,
+		    "commit_id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "submitted_at": "2019-05-15T15:21:14Z",
+		    "state": "commented",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2#pullrequestreview-237895671",
+		    "author_association": "OWNER"
+		  },
+		  "pull_request": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/pulls/2",
+		    "id": 279147437,
+		    "node_id": "MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2",
+		    "number": 2,
+		    "state": "open",
+		    "locked": false,
+		    "title": "Update the README with new information.",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "This is a pretty simple change that we need to pull into main.",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "closed_at": null,
+		    "merged_at": null,
+		    "merge_commit_sha": null,
+		    "assignees": [],
+		    "requested_reviewers": [],
+		    "labels": [],
+		    "draft": false,
+		    "head": {
+		      "label": "octo-org:changes",
+		      "ref": "changes",
+		      "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "base": {
+		      "label": "octo-org:main",
+		      "ref": "main",
+		      "sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "author_association": "OWNER",
+		    "merged": false,
+		    "mergeable": null,
+		    "comments": 0,
+		    "review_comments": 0,
+		    "commits": 1,
+		    "additions": 1,
+		    "deletions": 1,
+		    "changed_files": 1
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "pull_request_review.dismissed",
+		event:      "pull_request_review",
+		action:     "dismissed",
+		triggerLog: `{"type":"pull_request_review","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "dismissed",
+		  "review": {
+		    "id": 237895671,
+		    "node_id": "MDE3OlB1bGxSZXF1ZXN0UmV2aWV3MjM3ODk1Njcx",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": null,
+		    "commit_id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "submitted_at": "2019-05-15T15:21:14Z",
+		    "state": "dismissed",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2#pullrequestreview-237895671",
+		    "author_association": "OWNER"
+		  },
+		  "pull_request": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/pulls/2",
+		    "id": 279147437,
+		    "node_id": "MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2",
+		    "number": 2,
+		    "state": "open",
+		    "locked": false,
+		    "title": "Update the README with new information.",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "This is a pretty simple change that we need to pull into main.",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "closed_at": null,
+		    "merged_at": null,
+		    "merge_commit_sha": null,
+		    "assignees": [],
+		    "requested_reviewers": [],
+		    "labels": [],
+		    "draft": false,
+		    "head": {
+		      "label": "octo-org:changes",
+		      "ref": "changes",
+		      "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "base": {
+		      "label": "octo-org:main",
+		      "ref": "main",
+		      "sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "author_association": "OWNER",
+		    "merged": false,
+		    "mergeable": null,
+		    "comments": 0,
+		    "review_comments": 0,
+		    "commits": 1,
+		    "additions": 1,
+		    "deletions": 1,
+		    "changed_files": 1
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "pull_request_review_comment.created",
+		event:      "pull_request_review_comment",
+		action:     "created",
+		triggerLog: `{"type":"pull_request_review_comment","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "comment": {
+		    "id": 284312630,
+		    "node_id": "MDI0OlB1bGxSZXF1ZXN0UmV2aWV3Q29tbWVudDI4NDMxMjYzMA==",
+		    "pull_request_review_id": 237895671,
+		    "diff_hunk": "@@ -1 +1 @@\n-# hello-world",
+		    "path": "README.md",
+		    "position": 1,
+		    "original_position": 1,
+		    "commit_id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "original_commit_id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "Maybe you should use more emoji on this line.",
+		    "created_at": "2019-05-15T15:21:14Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2#discussion_r284312630",
+		    "author_association": "OWNER"
+		  },
+		  "pull_request": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/pulls/2",
+		    "id": 279147437,
+		    "node_id": "MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2",
+		    "number": 2,
+		    "state": "open",
+		    "locked": false,
+		    "title": "Update the README with new information.",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "This is a pretty simple change that we need to pull into main.",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "closed_at": null,
+		    "merged_at": null,
+		    "merge_commit_sha": null,
+		    "assignees": [],
+		    "requested_reviewers": [],
+		    "labels": [],
+		    "draft": false,
+		    "head": {
+		      "label": "octo-org:changes",
+		      "ref": "changes",
+		      "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "base": {
+		      "label": "octo-org:main",
+		      "ref": "main",
+		      "sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "author_association": "OWNER",
+		    "merged": false,
+		    "mergeable": null,
+		    "comments": 0,
+		    "review_comments": 0,
+		    "commits": 1,
+		    "additions": 1,
+		    "deletions": 1,
+		    "changed_files": 1
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "pull_request_review_comment.edited",
+		event:      "pull_request_review_comment",
+		action:     "edited",
+		triggerLog: `{"type":"pull_request_review_comment","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "edited",
+		  "comment": {
+		    "id": 284312630,
+		    "node_id": "MDI0OlB1bGxSZXF1ZXN0UmV2aWV3Q29tbWVudDI4NDMxMjYzMA==",
+		    "pull_request_review_id": 237895671,
+		    "diff_hunk": "@@ -1 +1 @@\n-# hello-world",
+		    "path": "README.md",
+		    "position": 1,
+		    "original_position": 1,
+		    "commit_id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "original_commit_id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "Maybe you should use more emoji on this line.",
+		    "created_at": "2019-05-15T15:21:14Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2#discussion_r284312630",
+		    "author_association": "OWNER"
+		  },
+		  "pull_request": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/pulls/2",
+		    "id": 279147437,
+		    "node_id": "MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3",
+		    "html_url": "https://github.com/octo-org/hello-world/pull/2",
+		    "number": 2,
+		    "state": "open",
+		    "locked": false,
+		    "title": "Update the README with new information.",
+		    "user": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "body": "This is a pretty simple change that we need to pull into main.",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "closed_at": null,
+		    "merged_at": null,
+		    "merge_commit_sha": null,
+		    "assignees": [],
+		    "requested_reviewers": [],
+		    "labels": [],
+		    "draft": false,
+		    "head": {
+		      "label": "octo-org:changes",
+		      "ref": "changes",
+		      "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "base": {
+		      "label": "octo-org:main",
+		      "ref": "main",
+		      "sha": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		      "user": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "repo": {
+		        "id": 186853002,
+		        "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		        "name": "hello-world",
+		        "full_name": "octo-org/hello-world",
+		        "private": false,
+		        "owner": {
+		          "login": "octo-org",
+		          "id": 6811672,
+		          "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		          "type": "Organization",
+		          "site_admin": false,
+		          "html_url": "https://github.com/octo-org",
+		          "url": "https://api.github.com/users/octo-org"
+		        },
+		        "html_url": "https://github.com/octo-org/hello-world",
+		        "description": null,
+		        "fork": false,
+		        "url": "https://api.github.com/repos/octo-org/hello-world",
+		        "created_at": "2019-05-15T15:19:25Z",
+		        "updated_at": "2019-05-15T15:19:27Z",
+		        "pushed_at": "2019-05-15T15:20:32Z",
+		        "clone_url": "https://github.com/octo-org/hello-world.git",
+		        "default_branch": "main",
+		        "stargazers_count": 0,
+		        "watchers_count": 0,
+		        "forks_count": 0,
+		        "open_issues_count": 1,
+		        "archived": false,
+		        "disabled": false,
+		        "visibility": "public"
+		      }
+		    },
+		    "author_association": "OWNER",
+		    "merged": false,
+		    "mergeable": null,
+		    "comments": 0,
+		    "review_comments": 0,
+		    "commits": 1,
+		    "additions": 1,
+		    "deletions": 1,
+		    "changed_files": 1
+		  },
+		  "changes": {
+		    "body": {
+		      "from": "Maybe use emoji."
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "push",
+		event:      "push",
+		action:     "",
+		triggerLog: `{"type":"push","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "ref": "refs/heads/main",
+		  "before": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+		  "after": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		  "created": false,
+		  "deleted": false,
+		  "forced": false,
+		  "base_ref": null,
+		  "compare": "https://github.com/octo-org/hello-world/compare/6113728f27ae...ec26c3e57ca3",
+		  "commits": [
+		    {
+		      "id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "tree_id": "f9d2a07e9488b91af2641b26b9407fe22a451433",
+		      "distinct": true,
+		      "message": "Update README.md",
+		      "timestamp": "2019-05-15T15:20:30Z",
+		      "url": "https://github.com/octo-org/hello-world/commit/ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "author": {
+		        "name": "Octo Cat",
+		        "email": "octocat@users.noreply.github.com",
+		        "username": "octocat"
+		      },
+		      "committer": {
+		        "name": "GitHub",
+		        "email": "noreply@github.com",
+		        "username": "web-flow"
+		      },
+		      "added": [],
+		      "removed": [],
+		      "modified": [
+		        "README.md"
+		      ]
+		    }
+		  ],
+		  "head_commit": {
+		    "id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "tree_id": "f9d2a07e9488b91af2641b26b9407fe22a451433",
+		    "distinct": true,
+		    "message": "Update README.md",
+		    "timestamp": "2019-05-15T15:20:30Z",
+		    "url": "https://github.com/octo-org/hello-world/commit/ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "author": {
+		      "name": "Octo Cat",
+		      "email": "octocat@users.noreply.github.com",
+		      "username": "octocat"
+		    },
+		    "committer": {
+		      "name": "GitHub",
+		      "email": "noreply@github.com",
+		      "username": "web-flow"
+		    },
+		    "added": [],
+		    "removed": [],
+		    "modified": [
+		      "README.md"
+		    ]
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": 1557933565,
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": 1557933632,
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public",
+		    "master_branch": "main"
+		  },
+		  "pusher": {
+		    "name": "octocat",
+		    "email": "octocat@users.noreply.github.com"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "registry_package.published",
+		event:      "registry_package",
+		action:     "published",
+		triggerLog: `{"type":"registry_package","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "published",
+		  "registry_package": {
+		    "id": 10696,
+		    "name": "hello-world-npm",
+		    "namespace": "octo-org",
+		    "description": null,
+		    "ecosystem": "npm",
+		    "package_type": "npm",
+		    "html_url": "https://github.com/octo-org/hello-world/packages/10696",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "package_version": {
+		      "id": 24147,
+		      "version": "1.0.0",
+		      "summary": null,
+		      "name": "1.0.0",
+		      "description": "",
+		      "html_url": "https://github.com/octo-org/hello-world/packages/10696?version=1.0.0",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "updated_at": "2019-05-15T15:20:33Z",
+		      "author": {
+		        "login": "octocat",
+		        "id": 583231,
+		        "node_id": "MDQ6VXNlcj583231",
+		        "type": "User",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octocat",
+		        "url": "https://api.github.com/users/octocat"
+		      }
+		    },
+		    "registry": {
+		      "about_url": "https://docs.github.com/packages",
+		      "name": "GitHub npm registry",
+		      "type": "npm",
+		      "url": "https://npm.pkg.github.com/octo-org",
+		      "vendor": "GitHub Inc"
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "release.published",
+		event:      "release",
+		action:     "published",
+		triggerLog: `{"type":"release","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "published",
+		  "release": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/releases/17372790",
+		    "html_url": "https://github.com/octo-org/hello-world/releases/tag/0.0.1",
+		    "id": 17372790,
+		    "node_id": "MDc6UmVsZWFzZTE3MzcyNzkw",
+		    "tag_name": "0.0.1",
+		    "target_commitish": "main",
+		    "name": null,
+		    "draft": false,
+		    "author": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "prerelease": false,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "published_at": "2019-05-15T15:21:14Z",
+		    "assets": [],
+		    "body": null
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "release.created",
+		event:      "release",
+		action:     "created",
+		triggerLog: `{"type":"release","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "release": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/releases/17372790",
+		    "html_url": "https://github.com/octo-org/hello-world/releases/tag/0.0.1",
+		    "id": 17372790,
+		    "node_id": "MDc6UmVsZWFzZTE3MzcyNzkw",
+		    "tag_name": "0.0.1",
+		    "target_commitish": "main",
+		    "name": null,
+		    "draft": false,
+		    "author": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "prerelease": false,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "published_at": "2019-05-15T15:21:14Z",
+		    "assets": [],
+		    "body": null
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "release.released",
+		event:      "release",
+		action:     "released",
+		triggerLog: `{"type":"release","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "released",
+		  "release": {
+		    "url": "https://api.github.com/repos/octo-org/hello-world/releases/17372790",
+		    "html_url": "https://github.com/octo-org/hello-world/releases/tag/0.0.1",
+		    "id": 17372790,
+		    "node_id": "MDc6UmVsZWFzZTE3MzcyNzkw",
+		    "tag_name": "0.0.1",
+		    "target_commitish": "main",
+		    "name": null,
+		    "draft": false,
+		    "author": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "prerelease": false,
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "published_at": "2019-05-15T15:21:14Z",
+		    "assets": [],
+		    "body": null
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "repository.created",
+		event:      "repository",
+		action:     "created",
+		triggerLog: `{"type":"repository","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "repository.archived",
+		event:      "repository",
+		action:     "archived",
+		triggerLog: `{"type":"repository","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "archived",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "repository.renamed",
+		event:      "repository",
+		action:     "renamed",
+		triggerLog: `{"type":"repository","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "renamed",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "changes": {
+		    "repository": {
+		      "name": {
+		        "from": "hello-world-old"
+		      }
+		    }
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "repository_dispatch.deploy",
+		event:      "repository_dispatch",
+		action:     "deploy",
+		triggerLog: `{"type":"repository_dispatch","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "deploy",
+		  "branch": "main",
+		  "client_payload": {
+		    "environment": "production",
+		    "unit": false
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "repository_vulnerability_alert.create",
+		event:      "repository_vulnerability_alert",
+		action:     "create",
+		triggerLog: `{"type":"repository_vulnerability_alert"}`,
+		payload: `{
+		  "action": "create",
+		  "alert": {
+		    "id": 91095730,
+		    "affected_range": ">= 2.0.4, < 2.0.6",
+		    "affected_package_name": "rack",
+		    "external_reference": "https://nvd.nist.gov/vuln/detail/CVE-2018-16470",
+		    "external_identifier": "CVE-2018-16470",
+		    "fixed_in": "2.0.6"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "repository_vulnerability_alert.dismiss",
+		event:      "repository_vulnerability_alert",
+		action:     "dismiss",
+		triggerLog: `{"type":"repository_vulnerability_alert"}`,
+		payload: `{
+		  "action": "dismiss",
+		  "alert": {
+		    "id": 91095730,
+		    "affected_range": ">= 2.0.4, < 2.0.6",
+		    "affected_package_name": "rack",
+		    "external_reference": "https://nvd.nist.gov/vuln/detail/CVE-2018-16470",
+		    "external_identifier": "CVE-2018-16470",
+		    "fixed_in": "2.0.6",
+		    "dismisser": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "dismiss_reason": "No bandwidth to fix this",
+		    "dismissed_at": "2017-10-25T00:00:00+00:00"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "repository_vulnerability_alert.resolve",
+		event:      "repository_vulnerability_alert",
+		action:     "resolve",
+		triggerLog: `{"type":"repository_vulnerability_alert"}`,
+		payload: `{
+		  "action": "resolve",
+		  "alert": {
+		    "id": 91095730,
+		    "affected_range": ">= 2.0.4, < 2.0.6",
+		    "affected_package_name": "rack",
+		    "external_reference": "https://nvd.nist.gov/vuln/detail/CVE-2018-16470",
+		    "external_identifier": "CVE-2018-16470",
+		    "fixed_in": "2.0.6"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "secret_scanning_alert.created",
+		event:      "secret_scanning_alert",
+		action:     "created",
+		triggerLog: `{"type":"secret_scanning_alert","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "created",
+		  "alert": {
+		    "number": 1,
+		    "secret_type": "github_personal_access_token",
+		    "secret_type_display_name": "GitHub Personal Access Token",
+		    "resolution": null,
+		    "resolved_by": null,
+		    "resolved_at": null,
+		    "url": "https://api.github.com/repos/octo-org/hello-world/secret-scanning/alerts/1",
+		    "html_url": "https://github.com/octo-org/hello-world/security/secret-scanning/1",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "state": "open"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "secret_scanning_alert.resolved",
+		event:      "secret_scanning_alert",
+		action:     "resolved",
+		triggerLog: `{"type":"secret_scanning_alert","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "resolved",
+		  "alert": {
+		    "number": 1,
+		    "secret_type": "github_personal_access_token",
+		    "secret_type_display_name": "GitHub Personal Access Token",
+		    "resolution": "revoked",
+		    "resolved_by": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "resolved_at": "2019-05-15T15:21:14Z",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/secret-scanning/alerts/1",
+		    "html_url": "https://github.com/octo-org/hello-world/security/secret-scanning/1",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "state": "resolved"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "sponsorship.created",
+		event:      "sponsorship",
+		action:     "created",
+		triggerLog: `{"type":"sponsorship","sender":"octocat"}`,
+		payload: `{
+		  "action": "created",
+		  "sponsorship": {
+		    "node_id": "MDExOlNwb25zb3JzaGlwMQ==",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "sponsorable": {
+		      "login": "octo-maintainer",
+		      "id": 1000,
+		      "node_id": "MDQ6VXNlcj1000",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-maintainer",
+		      "url": "https://api.github.com/users/octo-maintainer"
+		    },
+		    "sponsor": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "privacy_level": "public",
+		    "tier": {
+		      "node_id": "MDEyOlNwb25zb3JzVGllcjE=",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "description": "foo",
+		      "monthly_price_in_cents": 500,
+		      "monthly_price_in_dollars": 5,
+		      "name": "$5 a month",
+		      "is_one_time": false,
+		      "is_custom_ammount": false
+		    }
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  }
+		}`,
+	},
+	{
+		name:       "sponsorship.cancelled",
+		event:      "sponsorship",
+		action:     "cancelled",
+		triggerLog: `{"type":"sponsorship","sender":"octocat"}`,
+		payload: `{
+		  "action": "cancelled",
+		  "sponsorship": {
+		    "node_id": "MDExOlNwb25zb3JzaGlwMQ==",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "sponsorable": {
+		      "login": "octo-maintainer",
+		      "id": 1000,
+		      "node_id": "MDQ6VXNlcj1000",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-maintainer",
+		      "url": "https://api.github.com/users/octo-maintainer"
+		    },
+		    "sponsor": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "privacy_level": "public",
+		    "tier": {
+		      "node_id": "MDEyOlNwb25zb3JzVGllcjE=",
+		      "created_at": "2019-05-15T15:20:33Z",
+		      "description": "foo",
+		      "monthly_price_in_cents": 500,
+		      "monthly_price_in_dollars": 5,
+		      "name": "$5 a month",
+		      "is_one_time": false,
+		      "is_custom_ammount": false
+		    }
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  }
+		}`,
+	},
+	{
+		name:       "star.created",
+		event:      "star",
+		action:     "created",
+		triggerLog: `{"type":"star"}`,
+		payload: `{
+		  "action": "created",
+		  "starred_at": "2019-05-15T15:21:14Z",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "star.deleted",
+		event:      "star",
+		action:     "deleted",
+		triggerLog: `{"type":"star"}`,
+		payload: `{
+		  "action": "deleted",
+		  "starred_at": null,
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "status",
+		event:      "status",
+		action:     "",
+		triggerLog: `{"type":"status","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "id": 6805126730,
+		  "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		  "name": "octo-org/hello-world",
+		  "target_url": null,
+		  "context": "default",
+		  "description": null,
+		  "state": "success",
+		  "commit": {
+		    "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "commit": {
+		      "message": "Update README.md",
+		      "author": {
+		        "name": "Octo Cat",
+		        "email": "octocat@users.noreply.github.com",
+		        "date": "2019-05-15T15:20:33Z"
+		      }
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world/commit/ec26c3e57ca3a959ca5aad62de7213c562f8c821"
+		  },
+		  "branches": [
+		    {
+		      "name": "main",
+		      "commit": {
+		        "sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821"
+		      }
+		    }
+		  ],
+		  "created_at": "2019-05-15T15:20:33Z",
+		  "updated_at": "2019-05-15T15:20:33Z",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "team.created",
+		event:      "team",
+		action:     "created",
+		triggerLog: `{"type":"team","sender":"octocat","org":"octo-org"}`,
+		payload: `{
+		  "action": "created",
+		  "team": {
+		    "name": "github",
+		    "id": 3253328,
+		    "node_id": "MDQ6VGVhbTMyNTMzMjg=",
+		    "slug": "github",
+		    "description": "Open-source team",
+		    "privacy": "secret",
+		    "url": "https://api.github.com/teams/3253328",
+		    "permission": "pull"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "team.deleted",
+		event:      "team",
+		action:     "deleted",
+		triggerLog: `{"type":"team","sender":"octocat","org":"octo-org"}`,
+		payload: `{
+		  "action": "deleted",
+		  "team": {
+		    "name": "github",
+		    "id": 3253328,
+		    "node_id": "MDQ6VGVhbTMyNTMzMjg=",
+		    "slug": "github",
+		    "description": "Open-source team",
+		    "privacy": "secret",
+		    "url": "https://api.github.com/teams/3253328",
+		    "permission": "pull"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "team.added_to_repository",
+		event:      "team",
+		action:     "added_to_repository",
+		triggerLog: `{"type":"team","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "added_to_repository",
+		  "team": {
+		    "name": "github",
+		    "id": 3253328,
+		    "node_id": "MDQ6VGVhbTMyNTMzMjg=",
+		    "slug": "github",
+		    "description": "Open-source team",
+		    "privacy": "secret",
+		    "url": "https://api.github.com/teams/3253328",
+		    "permission": "pull"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "team_add",
+		event:      "team_add",
+		action:     "",
+		triggerLog: `{"type":"team_add","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "team": {
+		    "name": "github",
+		    "id": 3253328,
+		    "node_id": "MDQ6VGVhbTMyNTMzMjg=",
+		    "slug": "github",
+		    "description": "Open-source team",
+		    "privacy": "secret",
+		    "url": "https://api.github.com/teams/3253328",
+		    "permission": "pull"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "watch.started",
+		event:      "watch",
+		action:     "started",
+		triggerLog: `{"type":"watch","sender":"octocat","repo":"hello-world"}`,
+		payload: `{
+		  "action": "started",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "workflow_dispatch",
+		event:      "workflow_dispatch",
+		action:     "",
+		triggerLog: `{"type":"workflow_dispatch","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "inputs": {
+		    "name": "Mona the Octocat"
+		  },
+		  "ref": "refs/heads/main",
+		  "workflow": ".github/workflows/hello-world-workflow.yml",
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "workflow_job.queued",
+		event:      "workflow_job",
+		action:     "queued",
+		triggerLog: `{"type":"workflow_job","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "queued",
+		  "workflow_job": {
+		    "id": 2832853555,
+		    "run_id": 940463255,
+		    "run_url": "https://api.github.com/repos/octo-org/hello-world/actions/runs/940463255",
+		    "node_id": "MDg6Q2hlY2tSdW4yODMyODUzNTU1",
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/actions/jobs/2832853555",
+		    "html_url": "https://github.com/octo-org/hello-world/runs/2832853555",
+		    "status": "queued",
+		    "conclusion": null,
+		    "started_at": "2019-05-15T15:20:33Z",
+		    "completed_at": null,
+		    "name": "test",
+		    "steps": [],
+		    "labels": [
+		      "ubuntu-latest"
+		    ],
+		    "runner_id": null,
+		    "runner_name": null,
+		    "runner_group_id": null,
+		    "runner_group_name": null
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "workflow_job.completed",
+		event:      "workflow_job",
+		action:     "completed",
+		triggerLog: `{"type":"workflow_job","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "completed",
+		  "workflow_job": {
+		    "id": 2832853555,
+		    "run_id": 940463255,
+		    "run_url": "https://api.github.com/repos/octo-org/hello-world/actions/runs/940463255",
+		    "node_id": "MDg6Q2hlY2tSdW4yODMyODUzNTU1",
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/actions/jobs/2832853555",
+		    "html_url": "https://github.com/octo-org/hello-world/runs/2832853555",
+		    "status": "completed",
+		    "conclusion": "success",
+		    "started_at": "2019-05-15T15:20:33Z",
+		    "completed_at": "2019-05-15T15:21:14Z",
+		    "name": "test",
+		    "steps": [
+		      {
+		        "name": "Set up job",
+		        "status": "completed",
+		        "conclusion": "success",
+		        "number": 1,
+		        "started_at": "2019-05-15T15:20:33Z",
+		        "completed_at": "2019-05-15T15:21:14Z"
+		      }
+		    ],
+		    "labels": [
+		      "ubuntu-latest"
+		    ],
+		    "runner_id": 1,
+		    "runner_name": "GitHub Actions 1",
+		    "runner_group_id": 2,
+		    "runner_group_name": "GitHub Actions"
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "workflow_run.requested",
+		event:      "workflow_run",
+		action:     "requested",
+		triggerLog: `{"type":"workflow_run","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "requested",
+		  "workflow": {
+		    "id": 159038,
+		    "node_id": "MDg6V29ya2Zsb3cxNTkwMzg=",
+		    "name": "Build",
+		    "path": ".github/workflows/build.yml",
+		    "state": "active",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/actions/workflows/159038",
+		    "html_url": "https://github.com/octo-org/hello-world/blob/main/.github/workflows/build.yml",
+		    "badge_url": "https://github.com/octo-org/hello-world/workflows/Build/badge.svg"
+		  },
+		  "workflow_run": {
+		    "id": 30433642,
+		    "name": "Build",
+		    "node_id": "MDEyOldvcmtmbG93IFJ1bjI2OTI4OQ==",
+		    "head_branch": "main",
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "run_number": 562,
+		    "event": "push",
+		    "status": "queued",
+		    "conclusion": null,
+		    "workflow_id": 159038,
+		    "check_suite_id": 414944374,
+		    "url": "https://api.github.com/repos/octo-org/hello-world/actions/runs/30433642",
+		    "html_url": "https://github.com/octo-org/hello-world/actions/runs/30433642",
+		    "pull_requests": [],
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "run_attempt": 1,
+		    "run_started_at": "2019-05-15T15:20:33Z",
+		    "actor": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "triggering_actor": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "head_commit": {
+		      "id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "tree_id": "f9d2a07e9488b91af2641b26b9407fe22a451433",
+		      "message": "Update README.md",
+		      "timestamp": "2019-05-15T15:20:33Z"
+		    },
+		    "repository": {
+		      "id": 186853002,
+		      "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		      "name": "hello-world",
+		      "full_name": "octo-org/hello-world",
+		      "private": false,
+		      "owner": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "html_url": "https://github.com/octo-org/hello-world",
+		      "description": null,
+		      "fork": false,
+		      "url": "https://api.github.com/repos/octo-org/hello-world",
+		      "created_at": "2019-05-15T15:19:25Z",
+		      "updated_at": "2019-05-15T15:19:27Z",
+		      "pushed_at": "2019-05-15T15:20:32Z",
+		      "clone_url": "https://github.com/octo-org/hello-world.git",
+		      "default_branch": "main",
+		      "stargazers_count": 0,
+		      "watchers_count": 0,
+		      "forks_count": 0,
+		      "open_issues_count": 1,
+		      "archived": false,
+		      "disabled": false,
+		      "visibility": "public"
+		    },
+		    "head_repository": {
+		      "id": 186853002,
+		      "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		      "name": "hello-world",
+		      "full_name": "octo-org/hello-world",
+		      "private": false,
+		      "owner": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "html_url": "https://github.com/octo-org/hello-world",
+		      "description": null,
+		      "fork": false,
+		      "url": "https://api.github.com/repos/octo-org/hello-world",
+		      "created_at": "2019-05-15T15:19:25Z",
+		      "updated_at": "2019-05-15T15:19:27Z",
+		      "pushed_at": "2019-05-15T15:20:32Z",
+		      "clone_url": "https://github.com/octo-org/hello-world.git",
+		      "default_branch": "main",
+		      "stargazers_count": 0,
+		      "watchers_count": 0,
+		      "forks_count": 0,
+		      "open_issues_count": 1,
+		      "archived": false,
+		      "disabled": false,
+		      "visibility": "public"
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+	{
+		name:       "workflow_run.completed",
+		event:      "workflow_run",
+		action:     "completed",
+		triggerLog: `{"type":"workflow_run","sender":"octocat","org":"octo-org","repo":"hello-world"}`,
+		payload: `{
+		  "action": "completed",
+		  "workflow": {
+		    "id": 159038,
+		    "node_id": "MDg6V29ya2Zsb3cxNTkwMzg=",
+		    "name": "Build",
+		    "path": ".github/workflows/build.yml",
+		    "state": "active",
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:20:33Z",
+		    "url": "https://api.github.com/repos/octo-org/hello-world/actions/workflows/159038",
+		    "html_url": "https://github.com/octo-org/hello-world/blob/main/.github/workflows/build.yml",
+		    "badge_url": "https://github.com/octo-org/hello-world/workflows/Build/badge.svg"
+		  },
+		  "workflow_run": {
+		    "id": 30433642,
+		    "name": "Build",
+		    "node_id": "MDEyOldvcmtmbG93IFJ1bjI2OTI4OQ==",
+		    "head_branch": "main",
+		    "head_sha": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		    "run_number": 562,
+		    "event": "push",
+		    "status": "completed",
+		    "conclusion": "success",
+		    "workflow_id": 159038,
+		    "check_suite_id": 414944374,
+		    "url": "https://api.github.com/repos/octo-org/hello-world/actions/runs/30433642",
+		    "html_url": "https://github.com/octo-org/hello-world/actions/runs/30433642",
+		    "pull_requests": [],
+		    "created_at": "2019-05-15T15:20:33Z",
+		    "updated_at": "2019-05-15T15:21:14Z",
+		    "run_attempt": 1,
+		    "run_started_at": "2019-05-15T15:20:33Z",
+		    "actor": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "triggering_actor": {
+		      "login": "octocat",
+		      "id": 583231,
+		      "node_id": "MDQ6VXNlcj583231",
+		      "type": "User",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octocat",
+		      "url": "https://api.github.com/users/octocat"
+		    },
+		    "head_commit": {
+		      "id": "ec26c3e57ca3a959ca5aad62de7213c562f8c821",
+		      "tree_id": "f9d2a07e9488b91af2641b26b9407fe22a451433",
+		      "message": "Update README.md",
+		      "timestamp": "2019-05-15T15:20:33Z"
+		    },
+		    "repository": {
+		      "id": 186853002,
+		      "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		      "name": "hello-world",
+		      "full_name": "octo-org/hello-world",
+		      "private": false,
+		      "owner": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "html_url": "https://github.com/octo-org/hello-world",
+		      "description": null,
+		      "fork": false,
+		      "url": "https://api.github.com/repos/octo-org/hello-world",
+		      "created_at": "2019-05-15T15:19:25Z",
+		      "updated_at": "2019-05-15T15:19:27Z",
+		      "pushed_at": "2019-05-15T15:20:32Z",
+		      "clone_url": "https://github.com/octo-org/hello-world.git",
+		      "default_branch": "main",
+		      "stargazers_count": 0,
+		      "watchers_count": 0,
+		      "forks_count": 0,
+		      "open_issues_count": 1,
+		      "archived": false,
+		      "disabled": false,
+		      "visibility": "public"
+		    },
+		    "head_repository": {
+		      "id": 186853002,
+		      "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		      "name": "hello-world",
+		      "full_name": "octo-org/hello-world",
+		      "private": false,
+		      "owner": {
+		        "login": "octo-org",
+		        "id": 6811672,
+		        "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		        "type": "Organization",
+		        "site_admin": false,
+		        "html_url": "https://github.com/octo-org",
+		        "url": "https://api.github.com/users/octo-org"
+		      },
+		      "html_url": "https://github.com/octo-org/hello-world",
+		      "description": null,
+		      "fork": false,
+		      "url": "https://api.github.com/repos/octo-org/hello-world",
+		      "created_at": "2019-05-15T15:19:25Z",
+		      "updated_at": "2019-05-15T15:19:27Z",
+		      "pushed_at": "2019-05-15T15:20:32Z",
+		      "clone_url": "https://github.com/octo-org/hello-world.git",
+		      "default_branch": "main",
+		      "stargazers_count": 0,
+		      "watchers_count": 0,
+		      "forks_count": 0,
+		      "open_issues_count": 1,
+		      "archived": false,
+		      "disabled": false,
+		      "visibility": "public"
+		    }
+		  },
+		  "repository": {
+		    "id": 186853002,
+		    "node_id": "MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=",
+		    "name": "hello-world",
+		    "full_name": "octo-org/hello-world",
+		    "private": false,
+		    "owner": {
+		      "login": "octo-org",
+		      "id": 6811672,
+		      "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		      "type": "Organization",
+		      "site_admin": false,
+		      "html_url": "https://github.com/octo-org",
+		      "url": "https://api.github.com/users/octo-org"
+		    },
+		    "html_url": "https://github.com/octo-org/hello-world",
+		    "description": null,
+		    "fork": false,
+		    "url": "https://api.github.com/repos/octo-org/hello-world",
+		    "created_at": "2019-05-15T15:19:25Z",
+		    "updated_at": "2019-05-15T15:19:27Z",
+		    "pushed_at": "2019-05-15T15:20:32Z",
+		    "clone_url": "https://github.com/octo-org/hello-world.git",
+		    "default_branch": "main",
+		    "stargazers_count": 0,
+		    "watchers_count": 0,
+		    "forks_count": 0,
+		    "open_issues_count": 1,
+		    "archived": false,
+		    "disabled": false,
+		    "visibility": "public"
+		  },
+		  "organization": {
+		    "login": "octo-org",
+		    "id": 6811672,
+		    "node_id": "MDEyOk9yZ2FuaXphdGlvbjY4MTE2NzI=",
+		    "url": "https://api.github.com/orgs/octo-org",
+		    "repos_url": "https://api.github.com/orgs/octo-org/repos",
+		    "description": null
+		  },
+		  "sender": {
+		    "login": "octocat",
+		    "id": 583231,
+		    "node_id": "MDQ6VXNlcj583231",
+		    "type": "User",
+		    "site_admin": false,
+		    "html_url": "https://github.com/octocat",
+		    "url": "https://api.github.com/users/octocat"
+		  },
+		  "installation": {
+		    "id": 2311213,
+		    "node_id": "MDIzOkludGVncmF0aW9uSW5zdGFsbGF0aW9uMjMxMTIxMw=="
+		  }
+		}`,
+	},
+}
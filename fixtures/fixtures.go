@@ -0,0 +1,100 @@
+// Package fixtures provides webhook payloads for every event type ghbot
+// parses, with several actions each, for tests of bots and their hooks.
+package fixtures
+
+import (
+	"sort"
+
+	"golang.org/x/xerrors"
+)
+
+// ErrNotFound is returned when there is no fixture of the given name.
+var ErrNotFound = xerrors.New("fixture not found")
+
+type fixture struct {
+	name       string
+	event      string
+	action     string
+	triggerLog string
+	payload    string
+}
+
+// Fixture is a recorded webhook payload.
+type Fixture struct {
+	// Name is "<event>.<action>", or just the event type for events
+	// without an action. Variants of the same action get a suffix, e.g.
+	// "issue_comment.created.pull_request".
+	Name string
+	// Event is the event type, as sent in X-GitHub-Event.
+	Event string
+	// Action is the action of the payload, or empty.
+	Action string
+	// TriggerLog is the line a Bot logs when the payload triggers it.
+	TriggerLog string
+	Payload    []byte
+}
+
+func (f fixture) export() Fixture {
+	return Fixture{
+		Name:       f.name,
+		Event:      f.event,
+		Action:     f.action,
+		TriggerLog: f.triggerLog,
+		Payload:    []byte(f.payload),
+	}
+}
+
+// All returns every fixture, sorted by name.
+func All() []Fixture {
+	fs := make([]Fixture, 0, len(corpus))
+	for _, f := range corpus {
+		fs = append(fs, f.export())
+	}
+	sort.Slice(fs, func(i, j int) bool { return fs[i].Name < fs[j].Name })
+	return fs
+}
+
+// ForEvent returns the fixtures of the event type, sorted by name.
+func ForEvent(event string) []Fixture {
+	var fs []Fixture
+	for _, f := range All() {
+		if f.Event == event {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// Events returns the event types there are fixtures of, sorted.
+func Events() []string {
+	seen := map[string]bool{}
+	var events []string
+	for _, f := range corpus {
+		if !seen[f.event] {
+			seen[f.event] = true
+			events = append(events, f.event)
+		}
+	}
+	sort.Strings(events)
+	return events
+}
+
+// Load returns the fixture with the name, e.g. "pull_request.opened".
+func Load(name string) (Fixture, error) {
+	for _, f := range corpus {
+		if f.name == name {
+			return f.export(), nil
+		}
+	}
+	return Fixture{}, xerrors.Errorf("%s: %w", name, ErrNotFound)
+}
+
+// Payload returns the payload of the fixture with the name, panicking if
+// there is none. It is meant for tests, where the name is a constant.
+func Payload(name string) []byte {
+	f, err := Load(name)
+	if err != nil {
+		panic(err)
+	}
+	return f.Payload
+}
@@ -0,0 +1,88 @@
+package fixtures_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nasa9084/ghbot"
+	"github.com/nasa9084/ghbot/fixtures"
+	"github.com/nasa9084/ghbot/ghbottest"
+)
+
+// recordingLogger keeps the lines logged by a bot.
+type recordingLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *recordingLogger) log(s string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lines = append(l.lines, strings.TrimSpace(s))
+}
+
+func (l *recordingLogger) Print(v ...interface{})                 { l.log(fmt.Sprint(v...)) }
+func (l *recordingLogger) Printf(format string, v ...interface{}) { l.log(fmt.Sprintf(format, v...)) }
+func (l *recordingLogger) Println(v ...interface{})               { l.log(fmt.Sprintln(v...)) }
+
+func TestAll(t *testing.T) {
+	all := fixtures.All()
+	if len(all) == 0 {
+		t.Fatal("no fixtures")
+	}
+	for _, f := range all {
+		f := f
+		t.Run(f.Name, func(t *testing.T) {
+			var logger recordingLogger
+			bot := ghbot.New(ghbot.Config{WebHookSecret: "secret"})
+			bot.SetLogger(&logger)
+			var (
+				typ   string
+				event interface{}
+			)
+			bot.AddAnyEventHook(func(_ context.Context, t string, e interface{}) error {
+				typ, event = t, e
+				return nil
+			})
+
+			d, err := ghbottest.NewDelivery(f.Event, f.Payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ghbottest.New(bot, "secret").MustSend(t, d)
+
+			if typ != f.Event || event == nil {
+				t.Errorf("hooks of %s event did not run, got %s event %T", f.Event, typ, event)
+			}
+			if e, ok := event.(interface{ GetAction() string }); ok && e.GetAction() != f.Action {
+				t.Errorf("action = %q, want %q", e.GetAction(), f.Action)
+			}
+			found := false
+			for _, line := range logger.lines {
+				if line == f.TriggerLog {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("trigger log %s not logged, got %q", f.TriggerLog, logger.lines)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	f, err := fixtures.Load("pull_request.opened")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Event != "pull_request" || f.Action != "opened" || len(f.Payload) == 0 {
+		t.Errorf("Load(\"pull_request.opened\") = %+v", f)
+	}
+	if _, err := fixtures.Load("pull_request.unknown"); err == nil {
+		t.Error("no error loading an unknown fixture")
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/go-github/v25/github"
 	"golang.org/x/xerrors"
@@ -16,49 +18,27 @@ type Bot struct {
 	mu            sync.Mutex
 	webhookSecret []byte
 	logger        Logger
-
-	checkRunEventHooks                     []CheckRunEventHook
-	checkSuiteEventHooks                   []CheckSuiteEventHook
-	commitCommentEventHooks                []CommitCommentEventHook
-	createEventHooks                       []CreateEventHook
-	deleteEventHooks                       []DeleteEventHook
-	deployKeyEventHooks                    []DeployKeyEventHook
-	deploymentEventHooks                   []DeploymentEventHook
-	deploymentStatusEventHooks             []DeploymentStatusEventHook
-	forkEventHooks                         []ForkEventHook
-	gitHubAppAuthorizationEventHooks       []GitHubAppAuthorizationEventHook
-	gollumEventHooks                       []GollumEventHook
-	installationEventHooks                 []InstallationEventHook
-	installationRepositoriesEventHooks     []InstallationRepositoriesEventHook
-	issueCommentEventHooks                 []IssueCommentEventHook
-	issueEventHooks                        []IssueEventHook
-	issuesEventHooks                       []IssuesEventHook
-	labelEventHooks                        []LabelEventHook
-	marketplacePurchaseEventHooks          []MarketplacePurchaseEventHook
-	memberEventHooks                       []MemberEventHook
-	membershipEventHooks                   []MembershipEventHook
-	metaEventHooks                         []MetaEventHook
-	milestoneEventHooks                    []MilestoneEventHook
-	orgBlockEventHooks                     []OrgBlockEventHook
-	organizationEventHooks                 []OrganizationEventHook
-	pageBuildEventHooks                    []PageBuildEventHook
-	pingEventHooks                         []PingEventHook
-	projectCardEventHooks                  []ProjectCardEventHook
-	projectColumnEventHooks                []ProjectColumnEventHook
-	projectEventHooks                      []ProjectEventHook
-	publicEventHooks                       []PublicEventHook
-	pullRequestEventHooks                  []PullRequestEventHook
-	pullRequestReviewCommentEventHooks     []PullRequestReviewCommentEventHook
-	pullRequestReviewEventHooks            []PullRequestReviewEventHook
-	pushEventHooks                         []PushEventHook
-	releaseEventHooks                      []ReleaseEventHook
-	repositoryEventHooks                   []RepositoryEventHook
-	repositoryVulnerabilityAlertEventHooks []RepositoryVulnerabilityAlertEventHook
-	starEventHooks                         []StarEventHook
-	statusEventHooks                       []StatusEventHook
-	teamAddEventHooks                      []TeamAddEventHook
-	teamEventHooks                         []TeamEventHook
-	watchEventHooks                        []WatchEventHook
+	clientFactory ClientFactory
+	configErr     error
+	app           *appAuth
+	baseURL       *url.URL
+	uploadURL     *url.URL
+	installations *InstallationRegistry
+	recorder      *Recorder
+	queue         Transport
+	maxAttempts   int
+	// transport is shared by all clients the bot creates, below their
+	// authentication.
+	transport  http.RoundTripper
+	cache      *cacheTransport
+	rateLimits *rateLimitTransport
+	dryRun     *dryRunTransport
+
+	lastHookID       uint64
+	anyEventHooks    hookList
+	rawEventHooks    map[string]*hookList
+	eventHooks       map[string]*hookList
+	parallelDispatch map[string]int
 }
 
 type Logger interface {
@@ -75,13 +55,51 @@ func (l nopLogger) Println(...interface{})        {}
 
 type Config struct {
 	WebHookSecret string
+	// GitHubToken authenticates the client put into hooks' contexts.
+	// See ClientFromContext.
+	GitHubToken string
+
+	// AppID makes the bot authenticate as a GitHub App, using the PEM
+	// encoded private key in PrivateKey or read from PrivateKeyFile.
+	AppID          int64
+	PrivateKey     []byte
+	PrivateKeyFile string
+
+	// EnterpriseBaseURL and EnterpriseUploadURL point every client the
+	// bot creates, including the one exchanging App tokens, at a GitHub
+	// Enterprise Server, e.g. "https://ghe.example.com/api/v3/" and
+	// "https://ghe.example.com/api/uploads/". The upload URL is derived
+	// from the base URL when empty.
+	EnterpriseBaseURL   string
+	EnterpriseUploadURL string
 }
 
 func New(cfg Config) *Bot {
 	bot := Bot{
-		webhookSecret: []byte(cfg.WebHookSecret),
-		logger:        nopLogger{},
+		webhookSecret:    []byte(cfg.WebHookSecret),
+		logger:           nopLogger{},
+		rawEventHooks:    map[string]*hookList{},
+		eventHooks:       map[string]*hookList{},
+		parallelDispatch: map[string]int{},
+		maxAttempts:      defaultMaxDeliveryAttempts,
+		installations: &InstallationRegistry{
+			store: NewMemoryInstallationStore(),
+		},
+	}
+	bot.rateLimits = newRateLimitTransport(http.DefaultTransport)
+	bot.cache = &cacheTransport{
+		base:  bot.rateLimits,
+		cache: NewMemoryResponseCache(defaultResponseCacheSize),
 	}
+	bot.dryRun = &dryRunTransport{
+		base:   bot.cache,
+		logger: func() Logger { return bot.logger },
+	}
+	bot.transport = bot.dryRun
+	if bot.configErr = bot.setEnterpriseURLs(cfg); bot.configErr != nil {
+		return &bot
+	}
+	bot.clientFactory, bot.configErr = bot.defaultClientFactory(cfg)
 	return &bot
 }
 
@@ -93,8 +111,16 @@ func (bot *Bot) SetLogger(logger Logger) {
 }
 
 func (bot *Bot) Run(port int) error {
+	if err := bot.Validate(); err != nil {
+		return xerrors.Errorf("invalid configuration: %w", err)
+	}
+	if bot.app != nil {
+		if err := bot.ReconcileInstallations(context.Background()); err != nil {
+			bot.logger.Printf("error reconciling installations: %+v", err)
+		}
+	}
 	mux := http.NewServeMux()
-	mux.HandleFunc("/webhook/github", bot.githubWebHookHandler)
+	mux.Handle("/webhook/github", bot.Handler())
 	httpSrv := http.Server{
 		Addr:    ":" + strconv.Itoa(port),
 		Handler: mux,
@@ -102,22 +128,37 @@ func (bot *Bot) Run(port int) error {
 	return httpSrv.ListenAndServe()
 }
 
+// Handler returns the handler of webhook deliveries which Run serves at
+// /webhook/github, to be mounted on a server of one's own.
+func (bot *Bot) Handler() http.Handler {
+	return http.HandlerFunc(bot.githubWebHookHandler)
+}
+
 func (bot *Bot) githubWebHookHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return
 	}
-	payload, err := github.ValidatePayload(r, bot.webhookSecret)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+	receivedAt := time.Now()
+	bot.recordDelivery(r, receivedAt)
+	bot.mu.Lock()
+	queue := bot.queue
+	bot.mu.Unlock()
+	if queue != nil {
+		bot.enqueueDelivery(w, r, receivedAt, queue)
 		return
 	}
-	event, err := github.ParseWebHook(github.WebHookType(r), payload)
+	payload, err := github.ValidatePayload(r, bot.webhookSecret)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	if err := bot.handleWebHookEvent(r.Context(), github.WebHookType(r), event); err != nil {
+	ctx := withDelivery(r.Context(), newDeliveryMetadata(r, receivedAt))
+	if err := bot.handleWebHookPayload(ctx, github.WebHookType(r), payload); err != nil {
+		if xerrors.Is(err, errInvalidPayload) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
@@ -125,508 +166,551 @@ func (bot *Bot) githubWebHookHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func (bot *Bot) handleWebHookPayload(ctx context.Context, typ string, payload []byte) error {
+	ctx = withEventRepository(ctx, payloadRepository(payload))
+	ctx, err := bot.withClient(ctx, payloadInstallationID(payload))
+	if err != nil {
+		bot.logger.Printf("error creating GitHub client: %+v", err)
+		return xerrors.Errorf("error creating GitHub client: %w", err)
+	}
+	err = bot.runHooks(ctx, bot.rawEventHooksFor(typ), func(ctx context.Context, hook interface{}) error {
+		return hook.(RawEventHook)(ctx, json.RawMessage(payload))
+	})
+	if err != nil {
+		return err
+	}
+
+	event, err := parseWebHook(typ, payload)
+	if err != nil {
+		if xerrors.Is(err, errUnknownEventType) {
+			bot.logger.Printf("unsupported event type: %s", typ)
+			return nil
+		}
+		return err
+	}
+	return bot.handleWebHookEvent(ctx, typ, event)
+}
+
+// handleWebHookEvent runs the hooks of a parsed event. ctx already
+// carries the client handleWebHookPayload created for the delivery.
 func (bot *Bot) handleWebHookEvent(ctx context.Context, typ string, event interface{}) error {
+	var err error
 	switch e := event.(type) {
+	case *BranchProtectionRuleEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "branch_protection_rule", func(ctx context.Context, hook interface{}) error {
+			return hook.(BranchProtectionRuleEventHook)(ctx, e)
+		})
 	case *github.CheckRunEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Org:    *(e.GetOrg().Name),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.checkRunEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "check_run", func(ctx context.Context, hook interface{}) error {
+			return hook.(CheckRunEventHook)(ctx, e)
+		})
 	case *github.CheckSuiteEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Org:    *(e.GetOrg().Name),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.checkSuiteEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "check_suite", func(ctx context.Context, hook interface{}) error {
+			return hook.(CheckSuiteEventHook)(ctx, e)
+		})
+	case *CodeScanningAlertEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "code_scanning_alert", func(ctx context.Context, hook interface{}) error {
+			return hook.(CodeScanningAlertEventHook)(ctx, e)
+		})
 	case *github.CommitCommentEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.commitCommentEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "commit_comment", func(ctx context.Context, hook interface{}) error {
+			return hook.(CommitCommentEventHook)(ctx, e)
+		})
 	case *github.CreateEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.createEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "create", func(ctx context.Context, hook interface{}) error {
+			return hook.(CreateEventHook)(ctx, e)
+		})
 	case *github.DeleteEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.deleteEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "delete", func(ctx context.Context, hook interface{}) error {
+			return hook.(DeleteEventHook)(ctx, e)
+		})
 	case *github.DeployKeyEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type: typ,
 		}.String())
-		for _, hook := range bot.deployKeyEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "deploy_key", func(ctx context.Context, hook interface{}) error {
+			return hook.(DeployKeyEventHook)(ctx, e)
+		})
 	case *github.DeploymentEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.deploymentEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "deployment", func(ctx context.Context, hook interface{}) error {
+			return hook.(DeploymentEventHook)(ctx, e)
+		})
 	case *github.DeploymentStatusEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.deploymentStatusEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "deployment_status", func(ctx context.Context, hook interface{}) error {
+			return hook.(DeploymentStatusEventHook)(ctx, e)
+		})
+	case *DiscussionEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "discussion", func(ctx context.Context, hook interface{}) error {
+			return hook.(DiscussionEventHook)(ctx, e)
+		})
+	case *DiscussionCommentEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "discussion_comment", func(ctx context.Context, hook interface{}) error {
+			return hook.(DiscussionCommentEventHook)(ctx, e)
+		})
 	case *github.ForkEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.forkEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "fork", func(ctx context.Context, hook interface{}) error {
+			return hook.(ForkEventHook)(ctx, e)
+		})
 	case *github.GitHubAppAuthorizationEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
+			Sender: e.GetSender().GetLogin(),
 		}.String())
-		for _, hook := range bot.gitHubAppAuthorizationEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "github_app_authorization", func(ctx context.Context, hook interface{}) error {
+			return hook.(GitHubAppAuthorizationEventHook)(ctx, e)
+		})
 	case *github.GollumEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.gollumEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "gollum", func(ctx context.Context, hook interface{}) error {
+			return hook.(GollumEventHook)(ctx, e)
+		})
 	case *github.InstallationEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
+			Sender: e.GetSender().GetLogin(),
 		}.String())
-		for _, hook := range bot.installationEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
+		if err := bot.installations.handleInstallationEvent(ctx, e); err != nil {
+			bot.logger.Printf("error updating installation registry: %+v", err)
+			return xerrors.Errorf("error updating installation registry: %w", err)
 		}
+		err = bot.runEventHooks(ctx, "installation", func(ctx context.Context, hook interface{}) error {
+			return hook.(InstallationEventHook)(ctx, e)
+		})
 	case *github.InstallationRepositoriesEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
+			Sender: e.GetSender().GetLogin(),
 		}.String())
-		for _, hook := range bot.installationRepositoriesEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
+		if err := bot.installations.handleInstallationRepositoriesEvent(ctx, e); err != nil {
+			bot.logger.Printf("error updating installation registry: %+v", err)
+			return xerrors.Errorf("error updating installation registry: %w", err)
 		}
+		err = bot.runEventHooks(ctx, "installation_repositories", func(ctx context.Context, hook interface{}) error {
+			return hook.(InstallationRepositoriesEventHook)(ctx, e)
+		})
 	case *github.IssueCommentEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.issueCommentEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "issue_comment", func(ctx context.Context, hook interface{}) error {
+			return hook.(IssueCommentEventHook)(ctx, e)
+		})
 	case *github.IssueEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type: typ,
 		}.String())
-		for _, hook := range bot.issueEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "issue", func(ctx context.Context, hook interface{}) error {
+			return hook.(IssueEventHook)(ctx, e)
+		})
 	case *github.IssuesEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.issuesEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "issues", func(ctx context.Context, hook interface{}) error {
+			return hook.(IssuesEventHook)(ctx, e)
+		})
 	case *github.LabelEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type: typ,
-			Org:  *(e.GetOrg().Name),
-			Repo: *(e.GetRepo().Name),
-		}.String())
-		for _, hook := range bot.labelEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+			Org:  e.GetOrg().GetLogin(),
+			Repo: e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "label", func(ctx context.Context, hook interface{}) error {
+			return hook.(LabelEventHook)(ctx, e)
+		})
 	case *github.MarketplacePurchaseEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
+			Sender: e.GetSender().GetLogin(),
 		}.String())
-		for _, hook := range bot.marketplacePurchaseEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "marketplace_purchase", func(ctx context.Context, hook interface{}) error {
+			return hook.(MarketplacePurchaseEventHook)(ctx, e)
+		})
 	case *github.MemberEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.memberEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "member", func(ctx context.Context, hook interface{}) error {
+			return hook.(MemberEventHook)(ctx, e)
+		})
 	case *github.MembershipEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Org:    *(e.GetOrg().Name),
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
 		}.String())
-		for _, hook := range bot.membershipEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "membership", func(ctx context.Context, hook interface{}) error {
+			return hook.(MembershipEventHook)(ctx, e)
+		})
+	case *MergeGroupEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "merge_group", func(ctx context.Context, hook interface{}) error {
+			return hook.(MergeGroupEventHook)(ctx, e)
+		})
 	case *github.MetaEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type: typ,
 		}.String())
-		for _, hook := range bot.metaEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "meta", func(ctx context.Context, hook interface{}) error {
+			return hook.(MetaEventHook)(ctx, e)
+		})
 	case *github.MilestoneEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Org:    *(e.GetOrg().Name),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.milestoneEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "milestone", func(ctx context.Context, hook interface{}) error {
+			return hook.(MilestoneEventHook)(ctx, e)
+		})
 	case *github.OrgBlockEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
+			Sender: e.GetSender().GetLogin(),
 		}.String())
-		for _, hook := range bot.orgBlockEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "org_block", func(ctx context.Context, hook interface{}) error {
+			return hook.(OrgBlockEventHook)(ctx, e)
+		})
 	case *github.OrganizationEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
+			Sender: e.GetSender().GetLogin(),
 		}.String())
-		for _, hook := range bot.organizationEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "organization", func(ctx context.Context, hook interface{}) error {
+			return hook.(OrganizationEventHook)(ctx, e)
+		})
+	case *PackageEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "package", func(ctx context.Context, hook interface{}) error {
+			return hook.(PackageEventHook)(ctx, e)
+		})
 	case *github.PageBuildEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.pageBuildEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "page_build", func(ctx context.Context, hook interface{}) error {
+			return hook.(PageBuildEventHook)(ctx, e)
+		})
 	case *github.PingEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type: typ,
 		}.String())
-		for _, hook := range bot.pingEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "ping", func(ctx context.Context, hook interface{}) error {
+			return hook.(PingEventHook)(ctx, e)
+		})
 	case *github.ProjectCardEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Org:    *(e.GetOrg().Name),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.projectCardEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "project_card", func(ctx context.Context, hook interface{}) error {
+			return hook.(ProjectCardEventHook)(ctx, e)
+		})
 	case *github.ProjectColumnEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Org:    *(e.GetOrg().Name),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.projectColumnEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "project_column", func(ctx context.Context, hook interface{}) error {
+			return hook.(ProjectColumnEventHook)(ctx, e)
+		})
 	case *github.ProjectEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Org:    *(e.GetOrg().Name),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.projectEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "project", func(ctx context.Context, hook interface{}) error {
+			return hook.(ProjectEventHook)(ctx, e)
+		})
 	case *github.PublicEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.publicEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "public", func(ctx context.Context, hook interface{}) error {
+			return hook.(PublicEventHook)(ctx, e)
+		})
 	case *github.PullRequestEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.pullRequestEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "pull_request", func(ctx context.Context, hook interface{}) error {
+			return hook.(PullRequestEventHook)(ctx, e)
+		})
 	case *github.PullRequestReviewCommentEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.pullRequestReviewCommentEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "pull_request_review_comment", func(ctx context.Context, hook interface{}) error {
+			return hook.(PullRequestReviewCommentEventHook)(ctx, e)
+		})
 	case *github.PullRequestReviewEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.pullRequestReviewEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "pull_request_review", func(ctx context.Context, hook interface{}) error {
+			return hook.(PullRequestReviewEventHook)(ctx, e)
+		})
 	case *github.PushEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.pushEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "push", func(ctx context.Context, hook interface{}) error {
+			return hook.(PushEventHook)(ctx, e)
+		})
+	case *RegistryPackageEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "registry_package", func(ctx context.Context, hook interface{}) error {
+			return hook.(RegistryPackageEventHook)(ctx, e)
+		})
 	case *github.ReleaseEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.releaseEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "release", func(ctx context.Context, hook interface{}) error {
+			return hook.(ReleaseEventHook)(ctx, e)
+		})
 	case *github.RepositoryEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Org:    *(e.GetOrg().Name),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.repositoryEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "repository", func(ctx context.Context, hook interface{}) error {
+			return hook.(RepositoryEventHook)(ctx, e)
+		})
+	case *RepositoryDispatchEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "repository_dispatch", func(ctx context.Context, hook interface{}) error {
+			return hook.(RepositoryDispatchEventHook)(ctx, e)
+		})
 	case *github.RepositoryVulnerabilityAlertEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type: typ,
 		}.String())
-		for _, hook := range bot.repositoryVulnerabilityAlertEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "repository_vulnerability_alert", func(ctx context.Context, hook interface{}) error {
+			return hook.(RepositoryVulnerabilityAlertEventHook)(ctx, e)
+		})
+	case *SecretScanningAlertEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "secret_scanning_alert", func(ctx context.Context, hook interface{}) error {
+			return hook.(SecretScanningAlertEventHook)(ctx, e)
+		})
+	case *SponsorshipEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+		}.String())
+		err = bot.runEventHooks(ctx, "sponsorship", func(ctx context.Context, hook interface{}) error {
+			return hook.(SponsorshipEventHook)(ctx, e)
+		})
 	case *github.StarEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type: typ,
 		}.String())
-		for _, hook := range bot.starEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "star", func(ctx context.Context, hook interface{}) error {
+			return hook.(StarEventHook)(ctx, e)
+		})
 	case *github.StatusEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.statusEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "status", func(ctx context.Context, hook interface{}) error {
+			return hook.(StatusEventHook)(ctx, e)
+		})
 	case *github.TeamAddEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Org:    *(e.GetOrg().Name),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.teamAddEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "team_add", func(ctx context.Context, hook interface{}) error {
+			return hook.(TeamAddEventHook)(ctx, e)
+		})
 	case *github.TeamEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Org:    *(e.GetOrg().Name),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.teamEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "team", func(ctx context.Context, hook interface{}) error {
+			return hook.(TeamEventHook)(ctx, e)
+		})
 	case *github.WatchEvent:
 		bot.logger.Println(eventTriggerLog{
 			Type:   typ,
-			Sender: *(e.GetSender().Login),
-			Repo:   *(e.GetRepo().Name),
+			Sender: e.GetSender().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
 		}.String())
-		for _, hook := range bot.watchEventHooks {
-			if err := hook(ctx, e); err != nil {
-				bot.logger.Printf("error on hook: %+v", err)
-				return xerrors.Errorf("error on hook: %w", err)
-			}
-		}
+		err = bot.runEventHooks(ctx, "watch", func(ctx context.Context, hook interface{}) error {
+			return hook.(WatchEventHook)(ctx, e)
+		})
+	case *WorkflowDispatchEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "workflow_dispatch", func(ctx context.Context, hook interface{}) error {
+			return hook.(WorkflowDispatchEventHook)(ctx, e)
+		})
+	case *WorkflowJobEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "workflow_job", func(ctx context.Context, hook interface{}) error {
+			return hook.(WorkflowJobEventHook)(ctx, e)
+		})
+	case *WorkflowRunEvent:
+		bot.logger.Println(eventTriggerLog{
+			Type:   typ,
+			Sender: e.GetSender().GetLogin(),
+			Org:    e.GetOrg().GetLogin(),
+			Repo:   e.GetRepo().GetName(),
+		}.String())
+		err = bot.runEventHooks(ctx, "workflow_run", func(ctx context.Context, hook interface{}) error {
+			return hook.(WorkflowRunEventHook)(ctx, e)
+		})
 	default:
 		bot.logger.Printf("unsupported event type: %s", typ)
-		return xerrors.Errorf("unsupported event type: %s", typ)
 	}
-	return nil
+	if err != nil {
+		return err
+	}
+
+	return bot.runHooks(ctx, bot.anyEventHooksFor(), func(ctx context.Context, hook interface{}) error {
+		return hook.(AnyEventHook)(ctx, typ, event)
+	})
 }
 
 type eventTriggerLog struct {
@@ -644,254 +728,239 @@ func (etl eventTriggerLog) String() string {
 	return buf.String()
 }
 
-func (bot *Bot) AddCheckRunEventHook(hook CheckRunEventHook) {
+func (bot *Bot) AddAnyEventHook(hook AnyEventHook, opts ...HookOption) *HookHandle {
 	bot.mu.Lock()
 	defer bot.mu.Unlock()
-	bot.checkRunEventHooks = append(bot.checkRunEventHooks, hook)
+	return bot.addHook(&bot.anyEventHooks, hook, opts)
 }
 
-func (bot *Bot) AddCheckSuiteEventHook(hook CheckSuiteEventHook) {
+func (bot *Bot) AddRawEventHook(eventType string, hook RawEventHook, opts ...HookOption) *HookHandle {
 	bot.mu.Lock()
 	defer bot.mu.Unlock()
-	bot.checkSuiteEventHooks = append(bot.checkSuiteEventHooks, hook)
+	list, ok := bot.rawEventHooks[eventType]
+	if !ok {
+		list = &hookList{}
+		bot.rawEventHooks[eventType] = list
+	}
+	return bot.addHook(list, hook, opts)
 }
 
-func (bot *Bot) AddCommitCommentEventHook(hook CommitCommentEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.commitCommentEventHooks = append(bot.commitCommentEventHooks, hook)
+func (bot *Bot) AddBranchProtectionRuleEventHook(hook BranchProtectionRuleEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("branch_protection_rule", hook, opts)
 }
 
-func (bot *Bot) AddCreateEventHook(hook CreateEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.createEventHooks = append(bot.createEventHooks, hook)
+func (bot *Bot) AddCheckRunEventHook(hook CheckRunEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("check_run", hook, opts)
 }
 
-func (bot *Bot) AddDeleteEventHook(hook DeleteEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.deleteEventHooks = append(bot.deleteEventHooks, hook)
+func (bot *Bot) AddCheckSuiteEventHook(hook CheckSuiteEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("check_suite", hook, opts)
 }
 
-func (bot *Bot) AddDeployKeyEventHook(hook DeployKeyEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.deployKeyEventHooks = append(bot.deployKeyEventHooks, hook)
+func (bot *Bot) AddCodeScanningAlertEventHook(hook CodeScanningAlertEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("code_scanning_alert", hook, opts)
 }
 
-func (bot *Bot) AddDeploymentEventHook(hook DeploymentEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.deploymentEventHooks = append(bot.deploymentEventHooks, hook)
+func (bot *Bot) AddCommitCommentEventHook(hook CommitCommentEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("commit_comment", hook, opts)
 }
 
-func (bot *Bot) AddDeploymentStatusEventHook(hook DeploymentStatusEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.deploymentStatusEventHooks = append(bot.deploymentStatusEventHooks, hook)
+func (bot *Bot) AddCreateEventHook(hook CreateEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("create", hook, opts)
 }
 
-func (bot *Bot) AddForkEventHook(hook ForkEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.forkEventHooks = append(bot.forkEventHooks, hook)
+func (bot *Bot) AddDeleteEventHook(hook DeleteEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("delete", hook, opts)
 }
 
-func (bot *Bot) AddGitHubAppAuthorizationEventHook(hook GitHubAppAuthorizationEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.gitHubAppAuthorizationEventHooks = append(bot.gitHubAppAuthorizationEventHooks, hook)
+func (bot *Bot) AddDeployKeyEventHook(hook DeployKeyEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("deploy_key", hook, opts)
 }
 
-func (bot *Bot) AddGollumEventHook(hook GollumEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.gollumEventHooks = append(bot.gollumEventHooks, hook)
+func (bot *Bot) AddDeploymentEventHook(hook DeploymentEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("deployment", hook, opts)
 }
 
-func (bot *Bot) AddInstallationEventHook(hook InstallationEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.installationEventHooks = append(bot.installationEventHooks, hook)
+func (bot *Bot) AddDeploymentStatusEventHook(hook DeploymentStatusEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("deployment_status", hook, opts)
 }
 
-func (bot *Bot) AddInstallationRepositoriesEventHook(hook InstallationRepositoriesEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.installationRepositoriesEventHooks = append(bot.installationRepositoriesEventHooks, hook)
+func (bot *Bot) AddDiscussionEventHook(hook DiscussionEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("discussion", hook, opts)
 }
 
-func (bot *Bot) AddIssueCommentEventHook(hook IssueCommentEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.issueCommentEventHooks = append(bot.issueCommentEventHooks, hook)
+func (bot *Bot) AddDiscussionCommentEventHook(hook DiscussionCommentEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("discussion_comment", hook, opts)
 }
 
-func (bot *Bot) AddIssueEventHook(hook IssueEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.issueEventHooks = append(bot.issueEventHooks, hook)
+func (bot *Bot) AddForkEventHook(hook ForkEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("fork", hook, opts)
 }
 
-func (bot *Bot) AddIssuesEventHook(hook IssuesEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.issuesEventHooks = append(bot.issuesEventHooks, hook)
+func (bot *Bot) AddGitHubAppAuthorizationEventHook(hook GitHubAppAuthorizationEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("github_app_authorization", hook, opts)
 }
 
-func (bot *Bot) AddLabelEventHook(hook LabelEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.labelEventHooks = append(bot.labelEventHooks, hook)
+func (bot *Bot) AddGollumEventHook(hook GollumEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("gollum", hook, opts)
 }
 
-func (bot *Bot) AddMarketplacePurchaseEventHook(hook MarketplacePurchaseEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.marketplacePurchaseEventHooks = append(bot.marketplacePurchaseEventHooks, hook)
+func (bot *Bot) AddInstallationEventHook(hook InstallationEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("installation", hook, opts)
 }
 
-func (bot *Bot) AddMemberEventHook(hook MemberEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.memberEventHooks = append(bot.memberEventHooks, hook)
+func (bot *Bot) AddInstallationRepositoriesEventHook(hook InstallationRepositoriesEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("installation_repositories", hook, opts)
 }
 
-func (bot *Bot) AddMembershipEventHook(hook MembershipEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.membershipEventHooks = append(bot.membershipEventHooks, hook)
+func (bot *Bot) AddIssueCommentEventHook(hook IssueCommentEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("issue_comment", hook, opts)
 }
 
-func (bot *Bot) AddMetaEventHook(hook MetaEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.metaEventHooks = append(bot.metaEventHooks, hook)
+func (bot *Bot) AddIssueEventHook(hook IssueEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("issue", hook, opts)
 }
 
-func (bot *Bot) AddMilestoneEventHook(hook MilestoneEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.milestoneEventHooks = append(bot.milestoneEventHooks, hook)
+func (bot *Bot) AddIssuesEventHook(hook IssuesEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("issues", hook, opts)
 }
 
-func (bot *Bot) AddOrgBlockEventHook(hook OrgBlockEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.orgBlockEventHooks = append(bot.orgBlockEventHooks, hook)
+func (bot *Bot) AddLabelEventHook(hook LabelEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("label", hook, opts)
 }
 
-func (bot *Bot) AddOrganizationEventHook(hook OrganizationEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.organizationEventHooks = append(bot.organizationEventHooks, hook)
+func (bot *Bot) AddMarketplacePurchaseEventHook(hook MarketplacePurchaseEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("marketplace_purchase", hook, opts)
 }
 
-func (bot *Bot) AddPageBuildEventHook(hook PageBuildEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.pageBuildEventHooks = append(bot.pageBuildEventHooks, hook)
+func (bot *Bot) AddMemberEventHook(hook MemberEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("member", hook, opts)
 }
 
-func (bot *Bot) AddPingEventHook(hook PingEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.pingEventHooks = append(bot.pingEventHooks, hook)
+func (bot *Bot) AddMembershipEventHook(hook MembershipEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("membership", hook, opts)
 }
 
-func (bot *Bot) AddProjectCardEventHook(hook ProjectCardEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.projectCardEventHooks = append(bot.projectCardEventHooks, hook)
+func (bot *Bot) AddMergeGroupEventHook(hook MergeGroupEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("merge_group", hook, opts)
 }
 
-func (bot *Bot) AddProjectColumnEventHook(hook ProjectColumnEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.projectColumnEventHooks = append(bot.projectColumnEventHooks, hook)
+func (bot *Bot) AddMetaEventHook(hook MetaEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("meta", hook, opts)
 }
 
-func (bot *Bot) AddProjectEventHook(hook ProjectEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.projectEventHooks = append(bot.projectEventHooks, hook)
+func (bot *Bot) AddMilestoneEventHook(hook MilestoneEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("milestone", hook, opts)
 }
 
-func (bot *Bot) AddPublicEventHook(hook PublicEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.publicEventHooks = append(bot.publicEventHooks, hook)
+func (bot *Bot) AddOrgBlockEventHook(hook OrgBlockEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("org_block", hook, opts)
 }
 
-func (bot *Bot) AddPullRequestEventHook(hook PullRequestEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.pullRequestEventHooks = append(bot.pullRequestEventHooks, hook)
+func (bot *Bot) AddOrganizationEventHook(hook OrganizationEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("organization", hook, opts)
 }
 
-func (bot *Bot) AddPullRequestReviewCommentEventHook(hook PullRequestReviewCommentEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.pullRequestReviewCommentEventHooks = append(bot.pullRequestReviewCommentEventHooks, hook)
+func (bot *Bot) AddPackageEventHook(hook PackageEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("package", hook, opts)
 }
 
-func (bot *Bot) AddPullRequestReviewEventHook(hook PullRequestReviewEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.pullRequestReviewEventHooks = append(bot.pullRequestReviewEventHooks, hook)
+func (bot *Bot) AddPageBuildEventHook(hook PageBuildEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("page_build", hook, opts)
 }
 
-func (bot *Bot) AddPushEventHook(hook PushEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.pushEventHooks = append(bot.pushEventHooks, hook)
+func (bot *Bot) AddPingEventHook(hook PingEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("ping", hook, opts)
 }
 
-func (bot *Bot) AddReleaseEventHook(hook ReleaseEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.releaseEventHooks = append(bot.releaseEventHooks, hook)
+func (bot *Bot) AddProjectCardEventHook(hook ProjectCardEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("project_card", hook, opts)
 }
 
-func (bot *Bot) AddRepositoryEventHook(hook RepositoryEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.repositoryEventHooks = append(bot.repositoryEventHooks, hook)
+func (bot *Bot) AddProjectColumnEventHook(hook ProjectColumnEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("project_column", hook, opts)
 }
 
-func (bot *Bot) AddRepositoryVulnerabilityAlertEventHook(hook RepositoryVulnerabilityAlertEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.repositoryVulnerabilityAlertEventHooks = append(bot.repositoryVulnerabilityAlertEventHooks, hook)
+func (bot *Bot) AddProjectEventHook(hook ProjectEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("project", hook, opts)
 }
 
-func (bot *Bot) AddStarEventHook(hook StarEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.starEventHooks = append(bot.starEventHooks, hook)
+func (bot *Bot) AddPublicEventHook(hook PublicEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("public", hook, opts)
 }
 
-func (bot *Bot) AddStatusEventHook(hook StatusEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.statusEventHooks = append(bot.statusEventHooks, hook)
+func (bot *Bot) AddPullRequestEventHook(hook PullRequestEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("pull_request", hook, opts)
 }
 
-func (bot *Bot) AddTeamAddEventHook(hook TeamAddEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.teamAddEventHooks = append(bot.teamAddEventHooks, hook)
+func (bot *Bot) AddPullRequestReviewCommentEventHook(hook PullRequestReviewCommentEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("pull_request_review_comment", hook, opts)
 }
 
-func (bot *Bot) AddTeamEventHook(hook TeamEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.teamEventHooks = append(bot.teamEventHooks, hook)
+func (bot *Bot) AddPullRequestReviewEventHook(hook PullRequestReviewEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("pull_request_review", hook, opts)
 }
 
-func (bot *Bot) AddWatchEventHook(hook WatchEventHook) {
-	bot.mu.Lock()
-	defer bot.mu.Unlock()
-	bot.watchEventHooks = append(bot.watchEventHooks, hook)
+func (bot *Bot) AddPushEventHook(hook PushEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("push", hook, opts)
+}
+
+func (bot *Bot) AddRegistryPackageEventHook(hook RegistryPackageEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("registry_package", hook, opts)
+}
+
+func (bot *Bot) AddReleaseEventHook(hook ReleaseEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("release", hook, opts)
+}
+
+func (bot *Bot) AddRepositoryEventHook(hook RepositoryEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("repository", hook, opts)
+}
+
+func (bot *Bot) AddRepositoryDispatchEventHook(hook RepositoryDispatchEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("repository_dispatch", hook, opts)
+}
+
+func (bot *Bot) AddRepositoryVulnerabilityAlertEventHook(hook RepositoryVulnerabilityAlertEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("repository_vulnerability_alert", hook, opts)
+}
+
+func (bot *Bot) AddSecretScanningAlertEventHook(hook SecretScanningAlertEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("secret_scanning_alert", hook, opts)
+}
+
+func (bot *Bot) AddSponsorshipEventHook(hook SponsorshipEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("sponsorship", hook, opts)
+}
+
+func (bot *Bot) AddStarEventHook(hook StarEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("star", hook, opts)
+}
+
+func (bot *Bot) AddStatusEventHook(hook StatusEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("status", hook, opts)
+}
+
+func (bot *Bot) AddTeamAddEventHook(hook TeamAddEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("team_add", hook, opts)
+}
+
+func (bot *Bot) AddTeamEventHook(hook TeamEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("team", hook, opts)
+}
+
+func (bot *Bot) AddWatchEventHook(hook WatchEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("watch", hook, opts)
+}
+
+func (bot *Bot) AddWorkflowDispatchEventHook(hook WorkflowDispatchEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("workflow_dispatch", hook, opts)
+}
+
+func (bot *Bot) AddWorkflowJobEventHook(hook WorkflowJobEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("workflow_job", hook, opts)
+}
+
+func (bot *Bot) AddWorkflowRunEventHook(hook WorkflowRunEventHook, opts ...HookOption) *HookHandle {
+	return bot.addEventHook("workflow_run", hook, opts)
 }
@@ -0,0 +1,145 @@
+package ghbot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+// deliver serves payload as a delivery of the event type signed with
+// secret, and returns the status the bot responded with.
+func deliver(bot *Bot, secret, event, payload string) int {
+	r := httptest.NewRequest(http.MethodPost, "/webhook/github", strings.NewReader(payload))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("X-GitHub-Event", event)
+	r.Header.Set("X-GitHub-Delivery", NewDeliveryID())
+	signature, signature256 := SignPayload(secret, []byte(payload))
+	r.Header.Set("X-Hub-Signature", signature)
+	r.Header.Set("X-Hub-Signature-256", signature256)
+	w := httptest.NewRecorder()
+	bot.Handler().ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestHandler(t *testing.T) {
+	var ran []string
+	bot := New(Config{WebHookSecret: "secret"})
+	var logger lineLogger
+	bot.SetLogger(&logger)
+	bot.AddRawEventHook("push", func(_ context.Context, payload json.RawMessage) error {
+		ran = append(ran, "raw push")
+		return nil
+	})
+	bot.AddRawEventHook("made_up", func(_ context.Context, payload json.RawMessage) error {
+		if string(payload) != `{"answer":42}` {
+			t.Errorf("raw payload = %s", payload)
+		}
+		ran = append(ran, "raw made_up")
+		return nil
+	})
+	bot.AddPushEventHook(func(context.Context, *github.PushEvent) error {
+		ran = append(ran, "push")
+		return nil
+	})
+	bot.AddAnyEventHook(func(_ context.Context, typ string, event interface{}) error {
+		if _, ok := event.(*github.PushEvent); !ok {
+			t.Errorf("any hook got %T", event)
+		}
+		ran = append(ran, "any "+typ)
+		return nil
+	})
+
+	tests := []struct {
+		name   string
+		event  string
+		secret string
+		body   string
+		status int
+		ran    []string
+	}{
+		{"push", "push", "secret", `{"ref":"refs/heads/master"}`, http.StatusOK, []string{"raw push", "push", "any push"}},
+		// unknown types are acknowledged, and only raw hooks see them
+		{"unknown type", "made_up", "secret", `{"answer":42}`, http.StatusOK, []string{"raw made_up"}},
+		{"unknown type without hooks", "made_up_too", "secret", `{}`, http.StatusOK, nil},
+		{"invalid payload", "push", "secret", `{"ref":`, http.StatusBadRequest, []string{"raw push"}},
+		{"wrong type of field", "push", "secret", `{"ref":1}`, http.StatusBadRequest, []string{"raw push"}},
+		{"wrong secret", "push", "wrong", `{}`, http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		ran = nil
+		if status := deliver(bot, tt.secret, tt.event, tt.body); status != tt.status {
+			t.Errorf("%s: status = %d, want %d", tt.name, status, tt.status)
+		}
+		if strings.Join(ran, ", ") != strings.Join(tt.ran, ", ") {
+			t.Errorf("%s: ran %q, want %q", tt.name, ran, tt.ran)
+		}
+	}
+	if !strings.Contains(strings.Join(logger.lines, "\n"), "unsupported event type: made_up") {
+		t.Errorf("unknown type was not logged: %q", logger.lines)
+	}
+
+	w := httptest.NewRecorder()
+	bot.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/webhook/github", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET was answered with %d", w.Code)
+	}
+}
+
+func TestHandlerHookErrors(t *testing.T) {
+	errHook := xerrors.New("hook failed")
+	for _, tt := range []struct {
+		name string
+		add  func(bot *Bot)
+	}{
+		{"raw", func(bot *Bot) {
+			bot.AddRawEventHook("push", func(context.Context, json.RawMessage) error { return errHook })
+		}},
+		{"typed", func(bot *Bot) {
+			bot.AddPushEventHook(func(context.Context, *github.PushEvent) error { return errHook })
+		}},
+		{"any", func(bot *Bot) {
+			bot.AddAnyEventHook(func(context.Context, string, interface{}) error { return errHook })
+		}},
+	} {
+		bot := New(Config{WebHookSecret: "secret"})
+		tt.add(bot)
+		if status := deliver(bot, "secret", "push", `{}`); status != http.StatusInternalServerError {
+			t.Errorf("failing %s hook: status = %d", tt.name, status)
+		}
+		if err := bot.handleWebHookPayload(context.Background(), "push", []byte(`{}`)); !xerrors.Is(err, errHook) {
+			t.Errorf("failing %s hook: error = %v", tt.name, err)
+		}
+	}
+}
+
+func TestHandleWebHookEventDefault(t *testing.T) {
+	type madeUpEvent struct{}
+
+	bot := New(Config{})
+	var logger lineLogger
+	bot.SetLogger(&logger)
+	var got interface{}
+	bot.AddAnyEventHook(func(_ context.Context, typ string, event interface{}) error {
+		if typ != "made_up" {
+			t.Errorf("type = %q", typ)
+		}
+		got = event
+		return nil
+	})
+	event := &madeUpEvent{}
+	if err := bot.handleWebHookEvent(context.Background(), "made_up", event); err != nil {
+		t.Fatal(err)
+	}
+	if got != event {
+		t.Errorf("any hook got %v", got)
+	}
+	if len(logger.lines) != 1 || logger.lines[0] != "unsupported event type: made_up" {
+		t.Errorf("logged %q", logger.lines)
+	}
+}
@@ -0,0 +1,379 @@
+package ghbottest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+)
+
+// Defaults filled in by the builders.
+const (
+	DefaultRepo   = "octocat/hello-world"
+	DefaultUser   = "octocat"
+	DefaultBranch = "master"
+)
+
+func newUser(login string) *github.User {
+	return &github.User{
+		Login:   github.String(login),
+		ID:      github.Int64(int64(len(login))*1000 + 1),
+		Type:    github.String("User"),
+		HTMLURL: github.String("https://github.com/" + login),
+	}
+}
+
+func newRepository(fullName string) *github.Repository {
+	owner, name := fullName, fullName
+	if i := strings.Index(fullName, "/"); i >= 0 {
+		owner, name = fullName[:i], fullName[i+1:]
+	}
+	return &github.Repository{
+		ID:            github.Int64(int64(len(fullName))*1000 + 2),
+		Owner:         newUser(owner),
+		Name:          github.String(name),
+		FullName:      github.String(fullName),
+		HTMLURL:       github.String("https://github.com/" + fullName),
+		DefaultBranch: github.String(DefaultBranch),
+	}
+}
+
+func newInstallation(id int64) *github.Installation {
+	if id == 0 {
+		return nil
+	}
+	return &github.Installation{ID: github.Int64(id)}
+}
+
+func mustDelivery(event string, payload interface{}) *Delivery {
+	d, err := NewDelivery(event, payload)
+	if err != nil {
+		// go-github's event types always marshal
+		panic(err)
+	}
+	return d
+}
+
+// PullRequestBuilder builds pull_request deliveries.
+type PullRequestBuilder struct {
+	action         string
+	repo           string
+	sender         string
+	installationID int64
+	pr             github.PullRequest
+}
+
+// PullRequestOpened starts a delivery of pull request #1 being opened by
+// DefaultUser from a topic branch into DefaultBranch of DefaultRepo.
+func PullRequestOpened() *PullRequestBuilder {
+	return &PullRequestBuilder{
+		action: "opened",
+		repo:   DefaultRepo,
+		sender: DefaultUser,
+		pr: github.PullRequest{
+			Number: github.Int(1),
+			State:  github.String("open"),
+			Title:  github.String("Update README"),
+			Body:   github.String(""),
+			Head: &github.PullRequestBranch{
+				Ref: github.String("topic"),
+				SHA: github.String(strings.Repeat("1", 40)),
+			},
+			Base: &github.PullRequestBranch{
+				Ref: github.String(DefaultBranch),
+				SHA: github.String(strings.Repeat("0", 40)),
+			},
+		},
+	}
+}
+
+func (b *PullRequestBuilder) Action(action string) *PullRequestBuilder {
+	b.action = action
+	return b
+}
+
+// Repo sets the repository, written as "owner/name".
+func (b *PullRequestBuilder) Repo(fullName string) *PullRequestBuilder {
+	b.repo = fullName
+	return b
+}
+
+// Author sets both the author of the pull request and the sender.
+func (b *PullRequestBuilder) Author(login string) *PullRequestBuilder {
+	b.sender = login
+	return b
+}
+
+func (b *PullRequestBuilder) Number(number int) *PullRequestBuilder {
+	b.pr.Number = github.Int(number)
+	return b
+}
+
+func (b *PullRequestBuilder) Title(title string) *PullRequestBuilder {
+	b.pr.Title = github.String(title)
+	return b
+}
+
+func (b *PullRequestBuilder) Body(body string) *PullRequestBuilder {
+	b.pr.Body = github.String(body)
+	return b
+}
+
+// Branches sets the head and base branch.
+func (b *PullRequestBuilder) Branches(head, base string) *PullRequestBuilder {
+	b.pr.Head.Ref = github.String(head)
+	b.pr.Base.Ref = github.String(base)
+	return b
+}
+
+func (b *PullRequestBuilder) Labels(names ...string) *PullRequestBuilder {
+	b.pr.Labels = nil
+	for _, name := range names {
+		b.pr.Labels = append(b.pr.Labels, &github.Label{Name: github.String(name)})
+	}
+	return b
+}
+
+func (b *PullRequestBuilder) Draft() *PullRequestBuilder {
+	b.pr.Draft = github.Bool(true)
+	return b
+}
+
+// Installation sets the ID of the GitHub App installation the event is
+// delivered for.
+func (b *PullRequestBuilder) Installation(id int64) *PullRequestBuilder {
+	b.installationID = id
+	return b
+}
+
+// Event returns the event as the bot would parse it.
+func (b *PullRequestBuilder) Event() *github.PullRequestEvent {
+	pr := b.pr
+	head, base := *pr.Head, *pr.Base
+	pr.Head, pr.Base = &head, &base
+	repo := newRepository(b.repo)
+	pr.User = newUser(b.sender)
+	pr.HTMLURL = github.String(fmt.Sprintf("%s/pull/%d", repo.GetHTMLURL(), pr.GetNumber()))
+	pr.Head.Repo, pr.Base.Repo = repo, repo
+	return &github.PullRequestEvent{
+		Action:       github.String(b.action),
+		Number:       pr.Number,
+		PullRequest:  &pr,
+		Repo:         repo,
+		Sender:       newUser(b.sender),
+		Installation: newInstallation(b.installationID),
+	}
+}
+
+func (b *PullRequestBuilder) Delivery() *Delivery {
+	return mustDelivery("pull_request", b.Event())
+}
+
+// IssueCommentBuilder builds issue_comment deliveries.
+type IssueCommentBuilder struct {
+	action            string
+	repo              string
+	commenter         string
+	author            string
+	number            int
+	body              string
+	authorAssociation string
+	pullRequest       bool
+	installationID    int64
+}
+
+// IssueCommentCreated starts a delivery of DefaultUser commenting body
+// on issue #1 of DefaultRepo.
+func IssueCommentCreated(body string) *IssueCommentBuilder {
+	return &IssueCommentBuilder{
+		action:            "created",
+		repo:              DefaultRepo,
+		commenter:         DefaultUser,
+		author:            DefaultUser,
+		number:            1,
+		body:              body,
+		authorAssociation: "OWNER",
+	}
+}
+
+func (b *IssueCommentBuilder) Action(action string) *IssueCommentBuilder {
+	b.action = action
+	return b
+}
+
+// Repo sets the repository, written as "owner/name".
+func (b *IssueCommentBuilder) Repo(fullName string) *IssueCommentBuilder {
+	b.repo = fullName
+	return b
+}
+
+// Commenter sets the author of the comment and the sender.
+func (b *IssueCommentBuilder) Commenter(login, authorAssociation string) *IssueCommentBuilder {
+	b.commenter, b.authorAssociation = login, authorAssociation
+	return b
+}
+
+// IssueAuthor sets the author of the issue commented on.
+func (b *IssueCommentBuilder) IssueAuthor(login string) *IssueCommentBuilder {
+	b.author = login
+	return b
+}
+
+func (b *IssueCommentBuilder) Number(number int) *IssueCommentBuilder {
+	b.number = number
+	return b
+}
+
+// OnPullRequest makes the comment one on a pull request's conversation.
+func (b *IssueCommentBuilder) OnPullRequest() *IssueCommentBuilder {
+	b.pullRequest = true
+	return b
+}
+
+// Installation sets the ID of the GitHub App installation the event is
+// delivered for.
+func (b *IssueCommentBuilder) Installation(id int64) *IssueCommentBuilder {
+	b.installationID = id
+	return b
+}
+
+// Event returns the event as the bot would parse it.
+func (b *IssueCommentBuilder) Event() *github.IssueCommentEvent {
+	repo := newRepository(b.repo)
+	issue := &github.Issue{
+		Number:  github.Int(b.number),
+		State:   github.String("open"),
+		Title:   github.String("Something is broken"),
+		User:    newUser(b.author),
+		HTMLURL: github.String(fmt.Sprintf("%s/issues/%d", repo.GetHTMLURL(), b.number)),
+	}
+	if b.pullRequest {
+		issue.HTMLURL = github.String(fmt.Sprintf("%s/pull/%d", repo.GetHTMLURL(), b.number))
+		issue.PullRequestLinks = &github.PullRequestLinks{
+			HTMLURL: issue.HTMLURL,
+		}
+	}
+	now := time.Now().UTC()
+	return &github.IssueCommentEvent{
+		Action: github.String(b.action),
+		Issue:  issue,
+		Comment: &github.IssueComment{
+			ID:                github.Int64(now.UnixNano() / int64(time.Millisecond)),
+			Body:              github.String(b.body),
+			User:              newUser(b.commenter),
+			AuthorAssociation: github.String(b.authorAssociation),
+			CreatedAt:         &now,
+			UpdatedAt:         &now,
+		},
+		Repo:         repo,
+		Sender:       newUser(b.commenter),
+		Installation: newInstallation(b.installationID),
+	}
+}
+
+func (b *IssueCommentBuilder) Delivery() *Delivery {
+	return mustDelivery("issue_comment", b.Event())
+}
+
+// PushBuilder builds push deliveries.
+type PushBuilder struct {
+	ref            string
+	repo           string
+	pusher         string
+	before, after  string
+	commits        []github.PushEventCommit
+	installationID int64
+}
+
+// PushTo starts a delivery of DefaultUser pushing a commit to branch of
+// DefaultRepo.
+func PushTo(branch string) *PushBuilder {
+	return &PushBuilder{
+		ref:    "refs/heads/" + branch,
+		repo:   DefaultRepo,
+		pusher: DefaultUser,
+		before: strings.Repeat("0", 40),
+		after:  strings.Repeat("1", 40),
+	}
+}
+
+// Repo sets the repository, written as "owner/name".
+func (b *PushBuilder) Repo(fullName string) *PushBuilder {
+	b.repo = fullName
+	return b
+}
+
+func (b *PushBuilder) Pusher(login string) *PushBuilder {
+	b.pusher = login
+	return b
+}
+
+// Tag makes the push one of a tag instead of a branch.
+func (b *PushBuilder) Tag(tag string) *PushBuilder {
+	b.ref = "refs/tags/" + tag
+	return b
+}
+
+// Commit adds a commit, the last of which becomes the head.
+func (b *PushBuilder) Commit(sha, message string, modified ...string) *PushBuilder {
+	b.commits = append(b.commits, github.PushEventCommit{
+		ID:       github.String(sha),
+		Message:  github.String(message),
+		Modified: modified,
+	})
+	b.after = sha
+	return b
+}
+
+// Installation sets the ID of the GitHub App installation the event is
+// delivered for.
+func (b *PushBuilder) Installation(id int64) *PushBuilder {
+	b.installationID = id
+	return b
+}
+
+// Event returns the event as the bot would parse it.
+func (b *PushBuilder) Event() *github.PushEvent {
+	repo := newRepository(b.repo)
+	commits := b.commits
+	if len(commits) == 0 {
+		commits = []github.PushEventCommit{{
+			ID:      github.String(b.after),
+			Message: github.String("Update README"),
+		}}
+	}
+	var head *github.PushEventCommit
+	for i := range commits {
+		commits[i].Author = &github.CommitAuthor{
+			Login: github.String(b.pusher),
+			Name:  github.String(b.pusher),
+		}
+		head = &commits[i]
+	}
+	owner := repo.GetOwner()
+	return &github.PushEvent{
+		Ref:        github.String(b.ref),
+		Before:     github.String(b.before),
+		After:      github.String(b.after),
+		Created:    github.Bool(false),
+		Deleted:    github.Bool(false),
+		Commits:    commits,
+		HeadCommit: head,
+		Repo: &github.PushEventRepository{
+			ID:            repo.ID,
+			Name:          repo.Name,
+			FullName:      repo.FullName,
+			Owner:         &github.User{Login: owner.Login, Name: owner.Login},
+			HTMLURL:       repo.HTMLURL,
+			DefaultBranch: repo.DefaultBranch,
+		},
+		Pusher:       &github.User{Name: github.String(b.pusher)},
+		Sender:       newUser(b.pusher),
+		Installation: newInstallation(b.installationID),
+	}
+}
+
+func (b *PushBuilder) Delivery() *Delivery {
+	return mustDelivery("push", b.Event())
+}
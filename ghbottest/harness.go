@@ -0,0 +1,183 @@
+// Package ghbottest fires simulated webhook deliveries at a ghbot.Bot, so
+// that hooks can be tested end-to-end, and provides stand-ins for the
+// services a bot connects to.
+package ghbottest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/nasa9084/ghbot"
+	"golang.org/x/xerrors"
+)
+
+// Delivery is a webhook delivery as GitHub would send it.
+type Delivery struct {
+	Event   string
+	ID      string
+	Payload []byte
+	// Header holds headers sent in addition to the ones GitHub always
+	// sends, e.g. X-GitHub-Hook-ID.
+	Header http.Header
+}
+
+// NewDelivery returns a delivery of the event type with payload, which is
+// marshaled to JSON unless it is a []byte or json.RawMessage already.
+func NewDelivery(event string, payload interface{}) (*Delivery, error) {
+	var b []byte
+	switch p := payload.(type) {
+	case []byte:
+		b = p
+	case json.RawMessage:
+		b = p
+	default:
+		var err error
+		if b, err = json.Marshal(payload); err != nil {
+			return nil, xerrors.Errorf("error marshaling payload: %w", err)
+		}
+	}
+	return &Delivery{
+		Event:   event,
+		ID:      ghbot.NewDeliveryID(),
+		Payload: b,
+		Header:  http.Header{},
+	}, nil
+}
+
+// Sign returns the value of X-Hub-Signature for payload.
+func Sign(secret string, payload []byte) string {
+	signature, _ := ghbot.SignPayload(secret, payload)
+	return signature
+}
+
+// Sign256 returns the value of X-Hub-Signature-256 for payload.
+func Sign256(secret string, payload []byte) string {
+	_, signature256 := ghbot.SignPayload(secret, payload)
+	return signature256
+}
+
+// Request returns the delivery as a request signed with secret. No
+// signature is added when secret is empty. Its Content-Type is
+// application/json unless Header sets another.
+func (d *Delivery) Request(secret string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/webhook/github", bytes.NewReader(d.Payload))
+	for k, v := range d.Header {
+		r.Header[k] = v
+	}
+	if r.Header.Get("Content-Type") == "" {
+		r.Header.Set("Content-Type", "application/json")
+	}
+	r.Header.Set("User-Agent", "GitHub-Hookshot/ghbottest")
+	r.Header.Set("X-GitHub-Event", d.Event)
+	r.Header.Set("X-GitHub-Delivery", d.ID)
+	if secret != "" {
+		r.Header.Set("X-Hub-Signature", Sign(secret, d.Payload))
+		r.Header.Set("X-Hub-Signature-256", Sign256(secret, d.Payload))
+	}
+	return r
+}
+
+// Result is the outcome of a delivery.
+type Result struct {
+	// StatusCode is the status the bot responded with.
+	StatusCode int
+	// Hooks are the results of the hooks which ran, in the order they
+	// finished.
+	Hooks []ghbot.HookResult
+}
+
+// Errors returns the errors the hooks produced.
+func (r *Result) Errors() []error {
+	var errs []error
+	for _, h := range r.Hooks {
+		if h.Err != nil {
+			errs = append(errs, h.Err)
+		}
+	}
+	return errs
+}
+
+// Err returns the error of the named hook, or nil when it succeeded or
+// did not run.
+func (r *Result) Err(hook string) error {
+	for _, h := range r.Hooks {
+		if h.Hook == hook {
+			return h.Err
+		}
+	}
+	return nil
+}
+
+// Ran tells whether the named hook ran.
+func (r *Result) Ran(hook string) bool {
+	for _, h := range r.Hooks {
+		if h.Hook == hook {
+			return true
+		}
+	}
+	return false
+}
+
+// Harness delivers events to a bot through its webhook handler.
+type Harness struct {
+	Bot *ghbot.Bot
+	// Secret is the webhook secret the bot was configured with.
+	Secret string
+	// Context is the parent of the contexts deliveries are handled with,
+	// e.g. one carrying a client from ghbot.WithClient.
+	Context context.Context
+}
+
+// New returns a harness for bot, which validates deliveries with secret.
+func New(bot *ghbot.Bot, secret string) *Harness {
+	return &Harness{
+		Bot:     bot,
+		Secret:  secret,
+		Context: context.Background(),
+	}
+}
+
+// Send signs d and serves it with the bot's handler.
+func (h *Harness) Send(d *Delivery) *Result {
+	var (
+		mu     sync.Mutex
+		result Result
+	)
+	ctx := ghbot.WithHookObserver(h.Context, func(hr ghbot.HookResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		result.Hooks = append(result.Hooks, hr)
+	})
+	w := httptest.NewRecorder()
+	h.Bot.Handler().ServeHTTP(w, d.Request(h.Secret).WithContext(ctx))
+	result.StatusCode = w.Code
+	return &result
+}
+
+// SendEvent builds a delivery of the event type with payload and sends it.
+func (h *Harness) SendEvent(event string, payload interface{}) (*Result, error) {
+	d, err := NewDelivery(event, payload)
+	if err != nil {
+		return nil, err
+	}
+	return h.Send(d), nil
+}
+
+// MustSend sends d and fails t unless the bot responded 200 OK and every
+// hook succeeded.
+func (h *Harness) MustSend(t testing.TB, d *Delivery) *Result {
+	t.Helper()
+	result := h.Send(d)
+	for _, err := range result.Errors() {
+		t.Errorf("hook failed on %s event: %+v", d.Event, err)
+	}
+	if result.StatusCode != http.StatusOK {
+		t.Fatalf("delivery of %s event was answered with %d", d.Event, result.StatusCode)
+	}
+	return result
+}
@@ -0,0 +1,185 @@
+package ghbottest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+	"github.com/nasa9084/ghbot"
+	"golang.org/x/xerrors"
+)
+
+func TestNewDelivery(t *testing.T) {
+	tests := []struct {
+		payload interface{}
+		want    string
+	}{
+		{[]byte(`{"zen":"as is"}`), `{"zen":"as is"}`},
+		{json.RawMessage(`{"zen":"raw"}`), `{"zen":"raw"}`},
+		{map[string]string{"zen": "marshaled"}, `{"zen":"marshaled"}`},
+	}
+	for _, tt := range tests {
+		d, err := NewDelivery("ping", tt.payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(d.Payload) != tt.want || d.Event != "ping" || d.ID == "" {
+			t.Errorf("delivery of %T = %+v", tt.payload, d)
+		}
+	}
+	if _, err := NewDelivery("ping", make(chan int)); err == nil {
+		t.Error("payload which cannot be marshaled was accepted")
+	}
+}
+
+func TestDeliveryRequest(t *testing.T) {
+	d, err := NewDelivery("push", []byte(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Header.Set("X-GitHub-Hook-ID", "42")
+
+	r := d.Request("secret")
+	for header, want := range map[string]string{
+		"X-GitHub-Event":    "push",
+		"X-GitHub-Delivery": d.ID,
+		"X-GitHub-Hook-ID":  "42",
+		"Content-Type":      "application/json",
+	} {
+		if got := r.Header.Get(header); got != want {
+			t.Errorf("%s = %q, want %q", header, got, want)
+		}
+	}
+	for _, header := range []string{"X-Hub-Signature", "X-Hub-Signature-256"} {
+		if err := github.ValidateSignature(r.Header.Get(header), d.Payload, []byte("secret")); err != nil {
+			t.Errorf("%s: %v", header, err)
+		}
+	}
+
+	r = d.Request("")
+	if r.Header.Get("X-Hub-Signature") != "" || r.Header.Get("X-Hub-Signature-256") != "" {
+		t.Error("request without a secret was signed")
+	}
+}
+
+func TestHarness(t *testing.T) {
+	errHook := xerrors.New("hook failed")
+	bot := ghbot.New(ghbot.Config{WebHookSecret: "secret"})
+	var got *github.PullRequestEvent
+	bot.AddPullRequestEventHook(func(_ context.Context, e *github.PullRequestEvent) error {
+		got = e
+		return nil
+	}, ghbot.WithName("record"))
+	bot.AddPullRequestEventHook(func(_ context.Context, e *github.PullRequestEvent) error {
+		if e.GetPullRequest().GetDraft() {
+			return errHook
+		}
+		return nil
+	}, ghbot.WithName("no-drafts"))
+	h := New(bot, "secret")
+
+	result := h.MustSend(t, PullRequestOpened().Delivery())
+	if !result.Ran("record") || !result.Ran("no-drafts") || result.Ran("other") {
+		t.Errorf("hooks = %+v", result.Hooks)
+	}
+	if got.GetAction() != "opened" || got.GetRepo().GetFullName() != DefaultRepo {
+		t.Errorf("hook got %+v", got)
+	}
+
+	// the errors of the hooks are reported along with the status
+	result = h.Send(PullRequestOpened().Draft().Delivery())
+	if result.StatusCode != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", result.StatusCode)
+	}
+	if err := result.Err("no-drafts"); !xerrors.Is(err, errHook) {
+		t.Errorf("error of no-drafts = %v", err)
+	}
+	if err := result.Err("record"); err != nil {
+		t.Errorf("error of record = %v", err)
+	}
+	if errs := result.Errors(); len(errs) != 1 {
+		t.Errorf("errors = %v", errs)
+	}
+
+	// deliveries signed with another secret are rejected before any hook
+	h.Secret = "other"
+	result = h.Send(PullRequestOpened().Delivery())
+	if result.StatusCode != http.StatusBadRequest || len(result.Hooks) != 0 {
+		t.Errorf("delivery with a wrong signature: %d, %+v", result.StatusCode, result.Hooks)
+	}
+	h.Secret = ""
+	if result := h.Send(PullRequestOpened().Delivery()); result.StatusCode != http.StatusBadRequest {
+		t.Errorf("unsigned delivery: %d", result.StatusCode)
+	}
+
+	// the context of the harness reaches the hooks
+	h = New(bot, "secret")
+	client := github.NewClient(nil)
+	h.Context = ghbot.WithClient(context.Background(), client)
+	var hookClient *github.Client
+	bot.AddPushEventHook(func(ctx context.Context, _ *github.PushEvent) error {
+		hookClient = ghbot.ClientFromContext(ctx)
+		return nil
+	})
+	result, err := h.SendEvent("push", PushTo(DefaultBranch).Event())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.StatusCode != http.StatusOK || hookClient != client {
+		t.Errorf("status = %d, hook got client %p, want %p", result.StatusCode, hookClient, client)
+	}
+}
+
+func TestBuilders(t *testing.T) {
+	bot := ghbot.New(ghbot.Config{WebHookSecret: "secret"})
+	var (
+		pr      *github.PullRequestEvent
+		comment *github.IssueCommentEvent
+		push    *github.PushEvent
+	)
+	bot.AddPullRequestEventHook(func(_ context.Context, e *github.PullRequestEvent) error { pr = e; return nil })
+	bot.AddIssueCommentEventHook(func(_ context.Context, e *github.IssueCommentEvent) error { comment = e; return nil })
+	bot.AddPushEventHook(func(_ context.Context, e *github.PushEvent) error { push = e; return nil })
+	h := New(bot, "secret")
+
+	h.MustSend(t, PullRequestOpened().Delivery())
+	if pr.GetNumber() != 1 || pr.GetPullRequest().GetUser().GetLogin() != DefaultUser ||
+		pr.GetPullRequest().GetBase().GetRef() != DefaultBranch || pr.GetPullRequest().GetHead().GetRef() != "topic" ||
+		pr.GetInstallation() != nil {
+		t.Errorf("default pull request = %+v", pr)
+	}
+	h.MustSend(t, PullRequestOpened().Action("labeled").Repo("acme/widgets").Author("alice").Number(3).
+		Title("Fix").Branches("fix", "develop").Labels("bug", "lgtm").Installation(7).Delivery())
+	p := pr.GetPullRequest()
+	if pr.GetAction() != "labeled" || pr.GetRepo().GetFullName() != "acme/widgets" || pr.GetSender().GetLogin() != "alice" ||
+		p.GetUser().GetLogin() != "alice" || p.GetNumber() != 3 || p.GetTitle() != "Fix" ||
+		p.GetHead().GetRef() != "fix" || p.GetBase().GetRef() != "develop" || len(p.Labels) != 2 || p.Labels[1].GetName() != "lgtm" ||
+		p.GetHTMLURL() != "https://github.com/acme/widgets/pull/3" || pr.GetInstallation().GetID() != 7 {
+		t.Errorf("pull request = %+v", p)
+	}
+
+	h.MustSend(t, IssueCommentCreated("/lgtm").Delivery())
+	if comment.GetComment().GetBody() != "/lgtm" || comment.GetComment().GetAuthorAssociation() != "OWNER" ||
+		comment.GetIssue().GetNumber() != 1 || comment.GetIssue().IsPullRequest() {
+		t.Errorf("default comment = %+v", comment)
+	}
+	h.MustSend(t, IssueCommentCreated("/lgtm").Commenter("bob", "CONTRIBUTOR").IssueAuthor("alice").Number(5).OnPullRequest().Delivery())
+	if comment.GetSender().GetLogin() != "bob" || comment.GetComment().GetAuthorAssociation() != "CONTRIBUTOR" ||
+		comment.GetIssue().GetUser().GetLogin() != "alice" || !comment.GetIssue().IsPullRequest() ||
+		comment.GetIssue().GetHTMLURL() != "https://github.com/octocat/hello-world/pull/5" {
+		t.Errorf("comment = %+v", comment)
+	}
+
+	h.MustSend(t, PushTo("develop").Delivery())
+	if push.GetRef() != "refs/heads/develop" || len(push.Commits) != 1 || push.GetHeadCommit().GetID() != push.GetAfter() {
+		t.Errorf("default push = %+v", push)
+	}
+	h.MustSend(t, PushTo("").Tag("v1.0.0").Pusher("alice").Commit("abc", "first").Commit("def", "second", "README.md").Delivery())
+	if push.GetRef() != "refs/tags/v1.0.0" || push.GetAfter() != "def" || len(push.Commits) != 2 ||
+		push.GetHeadCommit().GetMessage() != "second" || push.GetHeadCommit().GetAuthor().GetLogin() != "alice" ||
+		len(push.GetHeadCommit().Modified) != 1 {
+		t.Errorf("push = %+v", push)
+	}
+}
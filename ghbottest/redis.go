@@ -0,0 +1,555 @@
+package ghbottest
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RedisServer is a stand-in for a Redis server, speaking the subset of
+// the stream commands ghbot.RedisTransport uses, so that queues can be
+// tested without Redis:
+//
+//	srv := ghbottest.NewRedisServer()
+//	defer srv.Close()
+//	transport := ghbot.NewRedisTransport(ghbot.RedisOptions{Addr: srv.Addr})
+type RedisServer struct {
+	// Addr is the "host:port" the server listens on.
+	Addr string
+
+	ln net.Listener
+
+	mu      sync.Mutex
+	streams map[string]*redisStream
+	// changed is closed when entries are added, to wake blocked readers.
+	changed chan struct{}
+	conns   map[net.Conn]bool
+}
+
+type redisStream struct {
+	lastID  streamID
+	entries []streamEntry
+	groups  map[string]*redisGroup
+}
+
+type streamEntry struct {
+	id     streamID
+	fields []string
+}
+
+type redisGroup struct {
+	lastDelivered streamID
+	pending       map[streamID]*pendingEntry
+}
+
+type pendingEntry struct {
+	consumer    string
+	deliveredAt time.Time
+	count       int64
+}
+
+type streamID struct {
+	ms, seq uint64
+}
+
+func parseStreamID(s string) (streamID, bool) {
+	parts := strings.SplitN(s, "-", 2)
+	ms, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return streamID{}, false
+	}
+	var seq uint64
+	if len(parts) == 2 {
+		if seq, err = strconv.ParseUint(parts[1], 10, 64); err != nil {
+			return streamID{}, false
+		}
+	}
+	return streamID{ms, seq}, true
+}
+
+func (id streamID) String() string {
+	return fmt.Sprintf("%d-%d", id.ms, id.seq)
+}
+
+func (id streamID) less(other streamID) bool {
+	return id.ms < other.ms || id.ms == other.ms && id.seq < other.seq
+}
+
+// NewRedisServer starts a server on a local port. It must be closed when
+// done.
+func NewRedisServer() *RedisServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("ghbottest: failed to listen on a port: %v", err))
+	}
+	s := &RedisServer{
+		Addr:    ln.Addr().String(),
+		ln:      ln,
+		streams: map[string]*redisStream{},
+		changed: make(chan struct{}),
+		conns:   map[net.Conn]bool{},
+	}
+	go s.serve()
+	return s
+}
+
+// Close stops the server and closes its connections.
+func (s *RedisServer) Close() {
+	s.ln.Close()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for c := range s.conns {
+		c.Close()
+	}
+}
+
+// Len returns the number of entries in the stream.
+func (s *RedisServer) Len(stream string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if st, ok := s.streams[stream]; ok {
+		return len(st.entries)
+	}
+	return 0
+}
+
+// Pending returns the number of entries delivered to the group and not
+// acknowledged yet.
+func (s *RedisServer) Pending(stream, group string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if st, ok := s.streams[stream]; ok {
+		if g, ok := st.groups[group]; ok {
+			return len(g.pending)
+		}
+	}
+	return 0
+}
+
+func (s *RedisServer) serve() {
+	for {
+		c, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns[c] = true
+		s.mu.Unlock()
+		go s.serveConn(c)
+	}
+}
+
+func (s *RedisServer) serveConn(c net.Conn) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		c.Close()
+	}()
+	r := bufio.NewReader(c)
+	w := bufio.NewWriter(c)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		writeReply(w, s.exec(args))
+		if err := w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimRight(line, "\r\n")
+	if !strings.HasPrefix(line, "*") {
+		return strings.Fields(line), nil
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimRight(line, "\r\n")[1:])
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
+
+// redisStatus and redisErr are replies; other replies are strings (bulk),
+// int64, nil and []interface{}.
+type (
+	redisStatus string
+	redisErr    string
+)
+
+func writeReply(w *bufio.Writer, reply interface{}) {
+	switch v := reply.(type) {
+	case redisStatus:
+		fmt.Fprintf(w, "+%s\r\n", v)
+	case redisErr:
+		fmt.Fprintf(w, "-%s\r\n", v)
+	case int64:
+		fmt.Fprintf(w, ":%d\r\n", v)
+	case string:
+		fmt.Fprintf(w, "$%d\r\n%s\r\n", len(v), v)
+	case []interface{}:
+		if v == nil {
+			w.WriteString("*-1\r\n")
+			return
+		}
+		fmt.Fprintf(w, "*%d\r\n", len(v))
+		for _, e := range v {
+			writeReply(w, e)
+		}
+	default:
+		w.WriteString("$-1\r\n")
+	}
+}
+
+func syntaxError() redisErr { return "ERR syntax error" }
+
+func (s *RedisServer) exec(args []string) interface{} {
+	if len(args) == 0 {
+		return redisErr("ERR empty command")
+	}
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return redisStatus("PONG")
+	case "AUTH", "SELECT":
+		return redisStatus("OK")
+	case "XADD":
+		return s.xadd(args[1:])
+	case "XGROUP":
+		return s.xgroup(args[1:])
+	case "XREADGROUP":
+		return s.xreadgroup(args[1:])
+	case "XAUTOCLAIM":
+		return s.xautoclaim(args[1:])
+	case "XPENDING":
+		return s.xpending(args[1:])
+	case "XACK":
+		return s.xack(args[1:])
+	case "XDEL":
+		return s.xdel(args[1:])
+	}
+	return redisErr(fmt.Sprintf("ERR unknown command '%s'", args[0]))
+}
+
+func (s *RedisServer) stream(key string, create bool) *redisStream {
+	st, ok := s.streams[key]
+	if !ok && create {
+		st = &redisStream{groups: map[string]*redisGroup{}}
+		s.streams[key] = st
+	}
+	return st
+}
+
+func (st *redisStream) entry(id streamID) (streamEntry, bool) {
+	i := sort.Search(len(st.entries), func(i int) bool { return !st.entries[i].id.less(id) })
+	if i < len(st.entries) && st.entries[i].id == id {
+		return st.entries[i], true
+	}
+	return streamEntry{}, false
+}
+
+func (e streamEntry) reply() interface{} {
+	fields := make([]interface{}, len(e.fields))
+	for i, f := range e.fields {
+		fields[i] = f
+	}
+	return []interface{}{e.id.String(), fields}
+}
+
+// XADD key * field value [field value ...]
+func (s *RedisServer) xadd(args []string) interface{} {
+	if len(args) < 4 || len(args)%2 != 0 || args[1] != "*" {
+		return syntaxError()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.stream(args[0], true)
+	id := streamID{ms: uint64(time.Now().UnixNano() / int64(time.Millisecond))}
+	if !st.lastID.less(id) {
+		id = streamID{st.lastID.ms, st.lastID.seq + 1}
+	}
+	st.lastID = id
+	st.entries = append(st.entries, streamEntry{id: id, fields: append([]string(nil), args[2:]...)})
+	close(s.changed)
+	s.changed = make(chan struct{})
+	return id.String()
+}
+
+// XGROUP CREATE key group id [MKSTREAM]
+func (s *RedisServer) xgroup(args []string) interface{} {
+	if len(args) < 4 || !strings.EqualFold(args[0], "CREATE") {
+		return syntaxError()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mkstream := len(args) > 4 && strings.EqualFold(args[4], "MKSTREAM")
+	st := s.stream(args[1], mkstream)
+	if st == nil {
+		return redisErr("ERR The XGROUP subcommand requires the key to exist")
+	}
+	if _, ok := st.groups[args[2]]; ok {
+		return redisErr("BUSYGROUP Consumer Group name already exists")
+	}
+	start := st.lastID
+	if args[3] != "$" {
+		id, ok := parseStreamID(args[3])
+		if !ok {
+			return syntaxError()
+		}
+		start = id
+	}
+	st.groups[args[2]] = &redisGroup{lastDelivered: start, pending: map[streamID]*pendingEntry{}}
+	return redisStatus("OK")
+}
+
+// XREADGROUP GROUP group consumer [COUNT n] [BLOCK ms] STREAMS key >
+func (s *RedisServer) xreadgroup(args []string) interface{} {
+	if len(args) < 6 || !strings.EqualFold(args[0], "GROUP") {
+		return syntaxError()
+	}
+	group, consumer := args[1], args[2]
+	count, block := 0, -1
+	i := 3
+	for ; i+1 < len(args); i += 2 {
+		switch strings.ToUpper(args[i]) {
+		case "COUNT":
+			count, _ = strconv.Atoi(args[i+1])
+			continue
+		case "BLOCK":
+			block, _ = strconv.Atoi(args[i+1])
+			continue
+		}
+		break
+	}
+	if len(args) != i+3 || !strings.EqualFold(args[i], "STREAMS") || args[i+2] != ">" {
+		return syntaxError()
+	}
+	key := args[i+1]
+
+	var deadline <-chan time.Time
+	if block > 0 {
+		timer := time.NewTimer(time.Duration(block) * time.Millisecond)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	for {
+		s.mu.Lock()
+		st := s.stream(key, false)
+		if st == nil || st.groups[group] == nil {
+			s.mu.Unlock()
+			return redisErr("NOGROUP No such key or consumer group")
+		}
+		g := st.groups[group]
+		var entries []interface{}
+		for _, e := range st.entries {
+			if !g.lastDelivered.less(e.id) {
+				continue
+			}
+			g.lastDelivered = e.id
+			g.pending[e.id] = &pendingEntry{consumer: consumer, deliveredAt: time.Now(), count: 1}
+			entries = append(entries, e.reply())
+			if count > 0 && len(entries) == count {
+				break
+			}
+		}
+		changed := s.changed
+		s.mu.Unlock()
+
+		if len(entries) > 0 {
+			return []interface{}{[]interface{}{key, entries}}
+		}
+		if block < 0 {
+			return []interface{}(nil)
+		}
+		select {
+		case <-changed:
+		case <-deadline:
+			return []interface{}(nil)
+		}
+	}
+}
+
+// XAUTOCLAIM key group consumer min-idle-time start [COUNT n]
+func (s *RedisServer) xautoclaim(args []string) interface{} {
+	if len(args) < 5 {
+		return syntaxError()
+	}
+	minIdle, err := strconv.ParseInt(args[3], 10, 64)
+	if err != nil {
+		return syntaxError()
+	}
+	start, ok := parseStreamID(args[4])
+	if !ok {
+		return syntaxError()
+	}
+	count := 100
+	if len(args) == 7 && strings.EqualFold(args[5], "COUNT") {
+		count, _ = strconv.Atoi(args[6])
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.stream(args[0], false)
+	if st == nil || st.groups[args[1]] == nil {
+		return redisErr("NOGROUP No such key or consumer group")
+	}
+	g := st.groups[args[1]]
+	ids := make([]streamID, 0, len(g.pending))
+	for id := range g.pending {
+		if !id.less(start) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].less(ids[j]) })
+	now := time.Now()
+	entries := []interface{}{}
+	deleted := []interface{}{}
+	for _, id := range ids {
+		if len(entries) == count {
+			break
+		}
+		p := g.pending[id]
+		if now.Sub(p.deliveredAt) < time.Duration(minIdle)*time.Millisecond {
+			continue
+		}
+		e, ok := st.entry(id)
+		if !ok {
+			delete(g.pending, id)
+			deleted = append(deleted, id.String())
+			continue
+		}
+		p.consumer, p.deliveredAt = args[2], now
+		p.count++
+		entries = append(entries, e.reply())
+	}
+	return []interface{}{"0-0", entries, deleted}
+}
+
+// XPENDING key group start end count
+func (s *RedisServer) xpending(args []string) interface{} {
+	if len(args) != 5 {
+		return syntaxError()
+	}
+	count, _ := strconv.Atoi(args[4])
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.stream(args[0], false)
+	if st == nil || st.groups[args[1]] == nil {
+		return redisErr("NOGROUP No such key or consumer group")
+	}
+	g := st.groups[args[1]]
+	inRange := func(id streamID) bool {
+		if args[2] != "-" {
+			if start, _ := parseStreamID(args[2]); id.less(start) {
+				return false
+			}
+		}
+		if args[3] != "+" {
+			if end, _ := parseStreamID(args[3]); end.less(id) {
+				return false
+			}
+		}
+		return true
+	}
+	var ids []streamID
+	for id := range g.pending {
+		if inRange(id) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].less(ids[j]) })
+	reply := []interface{}{}
+	now := time.Now()
+	for _, id := range ids {
+		if len(reply) == count {
+			break
+		}
+		p := g.pending[id]
+		idle := int64(now.Sub(p.deliveredAt) / time.Millisecond)
+		reply = append(reply, []interface{}{id.String(), p.consumer, idle, p.count})
+	}
+	return reply
+}
+
+// XACK key group id [id ...]
+func (s *RedisServer) xack(args []string) interface{} {
+	if len(args) < 3 {
+		return syntaxError()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.stream(args[0], false)
+	if st == nil || st.groups[args[1]] == nil {
+		return int64(0)
+	}
+	g := st.groups[args[1]]
+	var n int64
+	for _, arg := range args[2:] {
+		id, _ := parseStreamID(arg)
+		if _, ok := g.pending[id]; ok {
+			delete(g.pending, id)
+			n++
+		}
+	}
+	return n
+}
+
+// XDEL key id [id ...]
+func (s *RedisServer) xdel(args []string) interface{} {
+	if len(args) < 2 {
+		return syntaxError()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.stream(args[0], false)
+	if st == nil {
+		return int64(0)
+	}
+	var n int64
+	for _, arg := range args[1:] {
+		id, _ := parseStreamID(arg)
+		for i, e := range st.entries {
+			if e.id == id {
+				st.entries = append(st.entries[:i], st.entries[i+1:]...)
+				n++
+				break
+			}
+		}
+	}
+	return n
+}
@@ -0,0 +1,149 @@
+package ghbottest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RelayServer is a stand-in for a webhook relay service like smee.io,
+// which streams the deliveries published to it as Server-Sent Events in
+// the format ghbot.Bot.RunRelay reads:
+//
+//	srv := ghbottest.NewRelayServer()
+//	defer srv.Close()
+//	go bot.RunRelay(ctx, srv.URL, ghbot.RelayOptions{})
+//	srv.Publish(ghbottest.PushTo("master").Delivery(), secret)
+//
+// Clients get the deliveries published after the one whose ID they send
+// as Last-Event-ID, so none are missed while they reconnect.
+type RelayServer struct {
+	// URL is the channel to connect to.
+	URL string
+
+	srv *httptest.Server
+
+	mu          sync.Mutex
+	messages    [][]byte
+	clients     map[chan struct{}]bool
+	disconnect  chan struct{}
+	connections int
+}
+
+// NewRelayServer starts a relay server. It must be closed when done.
+func NewRelayServer() *RelayServer {
+	s := &RelayServer{
+		clients:    map[chan struct{}]bool{},
+		disconnect: make(chan struct{}),
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL + "/channel"
+	return s
+}
+
+func (s *RelayServer) Close() {
+	s.Disconnect()
+	s.srv.Close()
+}
+
+// Connections returns how often clients have connected.
+func (s *RelayServer) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.connections
+}
+
+// Disconnect ends the streams of the connected clients, as if the
+// connection had broken.
+func (s *RelayServer) Disconnect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	close(s.disconnect)
+	s.disconnect = make(chan struct{})
+}
+
+// Publish signs d with secret, unless it is empty, and streams it to the
+// clients as the relay would forward it.
+func (s *RelayServer) Publish(d *Delivery, secret string) {
+	msg := map[string]interface{}{}
+	for k, v := range d.Request(secret).Header {
+		msg[strings.ToLower(k)] = strings.Join(v, ", ")
+	}
+	msg["body"] = json.RawMessage(d.Payload)
+	msg["query"] = map[string]string{}
+	msg["timestamp"] = time.Now().UnixNano() / int64(time.Millisecond)
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, b)
+	for notify := range s.clients {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *RelayServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/channel" {
+		http.NotFound(w, r)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	next, _ := strconv.Atoi(r.Header.Get("Last-Event-ID"))
+
+	notify := make(chan struct{}, 1)
+	s.mu.Lock()
+	s.connections++
+	s.clients[notify] = true
+	disconnect := s.disconnect
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, notify)
+		s.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "event: ready\ndata: {}\n\n")
+	flusher.Flush()
+	for {
+		s.mu.Lock()
+		if next > len(s.messages) {
+			next = len(s.messages)
+		}
+		pending := s.messages[next:]
+		next = len(s.messages)
+		s.mu.Unlock()
+		for i, msg := range pending {
+			fmt.Fprintf(w, "id: %d\ndata: %s\n\n", next-len(pending)+i+1, msg)
+		}
+		flusher.Flush()
+
+		select {
+		case <-notify:
+		case <-disconnect:
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package ghbot
+
+type hookEntry struct {
+	id   uint64
+	hook interface{}
+	hookOptions
+}
+
+// hookList is a copy-on-write list of hooks. Writers replace entries while
+// holding Bot.mu, so a snapshot taken by a running delivery is never
+// modified underneath it.
+type hookList struct {
+	// registered is kept in registration order, entries in the resolved
+	// order the hooks are run in.
+	registered []*hookEntry
+	entries    []*hookEntry
+	err        error
+}
+
+func (l *hookList) add(e *hookEntry) {
+	registered := make([]*hookEntry, 0, len(l.registered)+1)
+	registered = append(registered, l.registered...)
+	l.registered = append(registered, e)
+	l.resolve()
+}
+
+func (l *hookList) remove(id uint64) bool {
+	for i, e := range l.registered {
+		if e.id != id {
+			continue
+		}
+		registered := make([]*hookEntry, 0, len(l.registered)-1)
+		registered = append(registered, l.registered[:i]...)
+		l.registered = append(registered, l.registered[i+1:]...)
+		l.resolve()
+		return true
+	}
+	return false
+}
+
+// HookHandle identifies a registered hook, or a group of hooks which are
+// removed together.
+type HookHandle struct {
+	bot  *Bot
+	list *hookList
+	id   uint64
+
+	group []*HookHandle
+}
+
+// Remove deregisters the hook. Deliveries which are already being
+// processed may still call the hook, but no delivery accepted after
+// Remove returns will. Calling Remove more than once is a no-op.
+func (h *HookHandle) Remove() {
+	if h.group != nil {
+		for _, g := range h.group {
+			g.Remove()
+		}
+		return
+	}
+	h.bot.mu.Lock()
+	defer h.bot.mu.Unlock()
+
+	prev := h.list.err
+	if h.list.remove(h.id) {
+		h.bot.logHookOrderError(h.list, prev)
+	}
+}
+
+func (bot *Bot) addHook(list *hookList, hook interface{}, opts []HookOption) *HookHandle {
+	bot.lastHookID++
+	entry := hookEntry{
+		id:   bot.lastHookID,
+		hook: hook,
+	}
+	for _, opt := range opts {
+		opt(&entry.hookOptions)
+	}
+	prev := list.err
+	list.add(&entry)
+	bot.logHookOrderError(list, prev)
+	return &HookHandle{
+		bot:  bot,
+		list: list,
+		id:   bot.lastHookID,
+	}
+}
+
+// logHookOrderError logs why the order of list cannot be resolved unless
+// it is the same problem as prev, since a bot only served through Handler
+// never calls Validate. It must be called with bot.mu held.
+func (bot *Bot) logHookOrderError(list *hookList, prev error) {
+	if list.err != nil && (prev == nil || prev.Error() != list.err.Error()) {
+		bot.logger.Printf("hooks run in registration order: %+v", list.err)
+	}
+}
+
+func (bot *Bot) addEventHook(eventType string, hook interface{}, opts []HookOption) *HookHandle {
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+
+	list, ok := bot.eventHooks[eventType]
+	if !ok {
+		list = &hookList{}
+		bot.eventHooks[eventType] = list
+	}
+	return bot.addHook(list, hook, opts)
+}
+
+func (bot *Bot) anyEventHooksFor() []*hookEntry {
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+
+	return bot.anyEventHooks.entries
+}
+
+func (bot *Bot) rawEventHooksFor(eventType string) []*hookEntry {
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+
+	if list, ok := bot.rawEventHooks[eventType]; ok {
+		return list.entries
+	}
+	return nil
+}
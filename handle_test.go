@@ -0,0 +1,74 @@
+package ghbot
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+)
+
+const pushPayload = `{"ref":"refs/heads/master","repository":{"full_name":"octocat/hello-world"}}`
+
+// TestHookHandleConcurrent registers and removes hooks while deliveries
+// are being handled. Run it with -race.
+func TestHookHandleConcurrent(t *testing.T) {
+	bot := New(Config{})
+	var calls int64
+	bot.AddPushEventHook(func(context.Context, *github.PushEvent) error {
+		atomic.AddInt64(&calls, 1)
+		return nil
+	})
+
+	const n = 100
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			if err := bot.handleWebHookPayload(context.Background(), "push", []byte(pushPayload)); err != nil {
+				t.Errorf("error handling push event: %+v", err)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			handles := []*HookHandle{
+				bot.AddPushEventHook(func(context.Context, *github.PushEvent) error { return nil }),
+				bot.AddRawEventHook("push", func(context.Context, json.RawMessage) error { return nil }),
+				bot.AddAnyEventHook(func(context.Context, string, interface{}) error { return nil }, WithPriority(i)),
+			}
+			for _, h := range handles {
+				h.Remove()
+				h.Remove()
+			}
+		}
+	}()
+	wg.Wait()
+
+	if calls := atomic.LoadInt64(&calls); calls != n {
+		t.Errorf("push hook ran %d times, want %d", calls, n)
+	}
+}
+
+func TestHookHandleRemove(t *testing.T) {
+	bot := New(Config{})
+	var calls int
+	h := bot.AddPushEventHook(func(context.Context, *github.PushEvent) error {
+		calls++
+		return nil
+	})
+	if err := bot.handleWebHookPayload(context.Background(), "push", []byte(pushPayload)); err != nil {
+		t.Fatal(err)
+	}
+	h.Remove()
+	if err := bot.handleWebHookPayload(context.Background(), "push", []byte(pushPayload)); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("push hook ran %d times after removal, want 1", calls)
+	}
+}
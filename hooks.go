@@ -2,18 +2,31 @@ package ghbot
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/google/go-github/v25/github"
 )
 
+// AnyEventHook is called for every parsed event after the typed hooks.
+// The event type is the value of the X-GitHub-Event header.
+type AnyEventHook func(context.Context, string, interface{}) error
+
+// RawEventHook receives the raw payload of a delivery, including ones
+// whose event type ghbot does not know.
+type RawEventHook func(context.Context, json.RawMessage) error
+
+type BranchProtectionRuleEventHook func(context.Context, *BranchProtectionRuleEvent) error
 type CheckRunEventHook func(context.Context, *github.CheckRunEvent) error
 type CheckSuiteEventHook func(context.Context, *github.CheckSuiteEvent) error
+type CodeScanningAlertEventHook func(context.Context, *CodeScanningAlertEvent) error
 type CommitCommentEventHook func(context.Context, *github.CommitCommentEvent) error
 type CreateEventHook func(context.Context, *github.CreateEvent) error
 type DeleteEventHook func(context.Context, *github.DeleteEvent) error
 type DeployKeyEventHook func(context.Context, *github.DeployKeyEvent) error
 type DeploymentEventHook func(context.Context, *github.DeploymentEvent) error
 type DeploymentStatusEventHook func(context.Context, *github.DeploymentStatusEvent) error
+type DiscussionEventHook func(context.Context, *DiscussionEvent) error
+type DiscussionCommentEventHook func(context.Context, *DiscussionCommentEvent) error
 type ForkEventHook func(context.Context, *github.ForkEvent) error
 type GitHubAppAuthorizationEventHook func(context.Context, *github.GitHubAppAuthorizationEvent) error
 type GollumEventHook func(context.Context, *github.GollumEvent) error
@@ -26,10 +39,12 @@ type LabelEventHook func(context.Context, *github.LabelEvent) error
 type MarketplacePurchaseEventHook func(context.Context, *github.MarketplacePurchaseEvent) error
 type MemberEventHook func(context.Context, *github.MemberEvent) error
 type MembershipEventHook func(context.Context, *github.MembershipEvent) error
+type MergeGroupEventHook func(context.Context, *MergeGroupEvent) error
 type MetaEventHook func(context.Context, *github.MetaEvent) error
 type MilestoneEventHook func(context.Context, *github.MilestoneEvent) error
 type OrgBlockEventHook func(context.Context, *github.OrgBlockEvent) error
 type OrganizationEventHook func(context.Context, *github.OrganizationEvent) error
+type PackageEventHook func(context.Context, *PackageEvent) error
 type PageBuildEventHook func(context.Context, *github.PageBuildEvent) error
 type PingEventHook func(context.Context, *github.PingEvent) error
 type ProjectCardEventHook func(context.Context, *github.ProjectCardEvent) error
@@ -40,11 +55,18 @@ type PullRequestEventHook func(context.Context, *github.PullRequestEvent) error
 type PullRequestReviewCommentEventHook func(context.Context, *github.PullRequestReviewCommentEvent) error
 type PullRequestReviewEventHook func(context.Context, *github.PullRequestReviewEvent) error
 type PushEventHook func(context.Context, *github.PushEvent) error
+type RegistryPackageEventHook func(context.Context, *RegistryPackageEvent) error
 type ReleaseEventHook func(context.Context, *github.ReleaseEvent) error
 type RepositoryEventHook func(context.Context, *github.RepositoryEvent) error
+type RepositoryDispatchEventHook func(context.Context, *RepositoryDispatchEvent) error
 type RepositoryVulnerabilityAlertEventHook func(context.Context, *github.RepositoryVulnerabilityAlertEvent) error
+type SecretScanningAlertEventHook func(context.Context, *SecretScanningAlertEvent) error
+type SponsorshipEventHook func(context.Context, *SponsorshipEvent) error
 type StarEventHook func(context.Context, *github.StarEvent) error
 type StatusEventHook func(context.Context, *github.StatusEvent) error
 type TeamAddEventHook func(context.Context, *github.TeamAddEvent) error
 type TeamEventHook func(context.Context, *github.TeamEvent) error
 type WatchEventHook func(context.Context, *github.WatchEvent) error
+type WorkflowDispatchEventHook func(context.Context, *WorkflowDispatchEvent) error
+type WorkflowJobEventHook func(context.Context, *WorkflowJobEvent) error
+type WorkflowRunEventHook func(context.Context, *WorkflowRunEvent) error
@@ -0,0 +1,432 @@
+package ghbot
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+// ErrInstallationNotFound is returned by InstallationStore.Get and the
+// queries of InstallationRegistry for unknown installations.
+var ErrInstallationNotFound = xerrors.New("installation not found")
+
+// InstallationRecord is what the bot knows about an installation of the
+// GitHub App it runs as.
+type InstallationRecord struct {
+	ID          int64  `json:"id"`
+	Account     string `json:"account"`
+	AccountType string `json:"account_type"`
+	// RepositorySelection is "all" or "selected".
+	RepositorySelection string `json:"repository_selection"`
+	Suspended           bool   `json:"suspended"`
+	// Repositories are the full names, "owner/repo", of the repositories
+	// the installation can access, sorted.
+	Repositories []string  `json:"repositories"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func (rec *InstallationRecord) addRepositories(repos []*github.Repository) {
+	set := map[string]bool{}
+	for _, name := range rec.Repositories {
+		set[name] = true
+	}
+	for _, repo := range repos {
+		set[repo.GetFullName()] = true
+	}
+	rec.Repositories = sortedSet(set)
+}
+
+func (rec *InstallationRecord) removeRepositories(repos []*github.Repository) {
+	set := map[string]bool{}
+	for _, name := range rec.Repositories {
+		set[name] = true
+	}
+	for _, repo := range repos {
+		delete(set, repo.GetFullName())
+	}
+	rec.Repositories = sortedSet(set)
+}
+
+func sortedSet(set map[string]bool) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// InstallationStore persists installation records.
+type InstallationStore interface {
+	Get(ctx context.Context, id int64) (*InstallationRecord, error)
+	List(ctx context.Context) ([]*InstallationRecord, error)
+	Save(ctx context.Context, rec *InstallationRecord) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// MemoryInstallationStore keeps installation records in memory.
+type MemoryInstallationStore struct {
+	mu      sync.Mutex
+	records map[int64]InstallationRecord
+}
+
+func NewMemoryInstallationStore() *MemoryInstallationStore {
+	return &MemoryInstallationStore{
+		records: map[int64]InstallationRecord{},
+	}
+}
+
+func (s *MemoryInstallationStore) Get(_ context.Context, id int64) (*InstallationRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.records[id]
+	if !ok {
+		return nil, ErrInstallationNotFound
+	}
+	return copyInstallationRecord(rec), nil
+}
+
+func (s *MemoryInstallationStore) List(_ context.Context) ([]*InstallationRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	recs := make([]*InstallationRecord, 0, len(s.records))
+	for _, rec := range s.records {
+		recs = append(recs, copyInstallationRecord(rec))
+	}
+	sort.Slice(recs, func(i, j int) bool { return recs[i].ID < recs[j].ID })
+	return recs, nil
+}
+
+func (s *MemoryInstallationStore) Save(_ context.Context, rec *InstallationRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[rec.ID] = *copyInstallationRecord(*rec)
+	return nil
+}
+
+func (s *MemoryInstallationStore) Delete(_ context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, id)
+	return nil
+}
+
+func copyInstallationRecord(rec InstallationRecord) *InstallationRecord {
+	rec.Repositories = append([]string(nil), rec.Repositories...)
+	return &rec
+}
+
+// FileInstallationStore keeps installation records in memory and writes
+// all of them to a JSON file on every change.
+type FileInstallationStore struct {
+	MemoryInstallationStore
+	path string
+}
+
+// NewFileInstallationStore loads the records in the file at path, if it
+// exists.
+func NewFileInstallationStore(path string) (*FileInstallationStore, error) {
+	s := FileInstallationStore{
+		MemoryInstallationStore: MemoryInstallationStore{
+			records: map[int64]InstallationRecord{},
+		},
+		path: path,
+	}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &s, nil
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("error reading installation store: %w", err)
+	}
+	var recs []InstallationRecord
+	if err := json.Unmarshal(b, &recs); err != nil {
+		return nil, xerrors.Errorf("error parsing installation store: %w", err)
+	}
+	for _, rec := range recs {
+		s.records[rec.ID] = rec
+	}
+	return &s, nil
+}
+
+func (s *FileInstallationStore) Save(ctx context.Context, rec *InstallationRecord) error {
+	if err := s.MemoryInstallationStore.Save(ctx, rec); err != nil {
+		return err
+	}
+	return s.flush(ctx)
+}
+
+func (s *FileInstallationStore) Delete(ctx context.Context, id int64) error {
+	if err := s.MemoryInstallationStore.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.flush(ctx)
+}
+
+func (s *FileInstallationStore) flush(ctx context.Context) error {
+	recs, err := s.List(ctx)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(recs, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(s.path, b); err != nil {
+		return xerrors.Errorf("error writing installation store: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes b to a temporary file next to path and renames
+// it, so that neither a crash nor a concurrent reader ever sees a
+// truncated file.
+func writeFileAtomic(path string, b []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// InstallationRegistry tracks the installations of the GitHub App the bot
+// runs as, from installation and installation_repositories events and
+// from reconciliation against the API.
+type InstallationRegistry struct {
+	// mu guards store and serializes updates, which read and write back a
+	// record.
+	mu    sync.Mutex
+	store InstallationStore
+}
+
+// currentStore returns the store queries read from, which
+// SetInstallationStore may replace.
+func (r *InstallationRegistry) currentStore() InstallationStore {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store
+}
+
+// Installations returns the records of all known installations, sorted by
+// ID when the store keeps them in memory.
+func (r *InstallationRegistry) Installations(ctx context.Context) ([]*InstallationRecord, error) {
+	return r.currentStore().List(ctx)
+}
+
+// Installation returns the record of the installation, or
+// ErrInstallationNotFound.
+func (r *InstallationRegistry) Installation(ctx context.Context, id int64) (*InstallationRecord, error) {
+	return r.currentStore().Get(ctx, id)
+}
+
+// Repositories returns the full names of the repositories the
+// installation can access.
+func (r *InstallationRegistry) Repositories(ctx context.Context, installationID int64) ([]string, error) {
+	rec, err := r.currentStore().Get(ctx, installationID)
+	if err != nil {
+		return nil, err
+	}
+	return rec.Repositories, nil
+}
+
+// InstallationForRepository returns the installation which can access the
+// repository given as "owner/repo".
+func (r *InstallationRegistry) InstallationForRepository(ctx context.Context, fullName string) (*InstallationRecord, error) {
+	recs, err := r.currentStore().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range recs {
+		for _, name := range rec.Repositories {
+			if strings.EqualFold(name, fullName) {
+				return rec, nil
+			}
+		}
+	}
+	return nil, ErrInstallationNotFound
+}
+
+func (r *InstallationRegistry) update(ctx context.Context, inst *github.Installation, f func(*InstallationRecord)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, err := r.store.Get(ctx, inst.GetID())
+	if xerrors.Is(err, ErrInstallationNotFound) {
+		rec = &InstallationRecord{ID: inst.GetID()}
+	} else if err != nil {
+		return err
+	}
+	if login := inst.GetAccount().GetLogin(); login != "" {
+		rec.Account = login
+		rec.AccountType = inst.GetAccount().GetType()
+	}
+	if sel := inst.GetRepositorySelection(); sel != "" {
+		rec.RepositorySelection = sel
+	}
+	f(rec)
+	rec.UpdatedAt = time.Now()
+	return r.store.Save(ctx, rec)
+}
+
+func (r *InstallationRegistry) handleInstallationEvent(ctx context.Context, e *github.InstallationEvent) error {
+	switch e.GetAction() {
+	case "deleted":
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return r.store.Delete(ctx, e.GetInstallation().GetID())
+	case "created":
+		return r.update(ctx, e.GetInstallation(), func(rec *InstallationRecord) {
+			rec.Repositories = nil
+			rec.addRepositories(e.Repositories)
+		})
+	case "suspend":
+		return r.update(ctx, e.GetInstallation(), func(rec *InstallationRecord) {
+			rec.Suspended = true
+		})
+	case "unsuspend":
+		return r.update(ctx, e.GetInstallation(), func(rec *InstallationRecord) {
+			rec.Suspended = false
+		})
+	}
+	return r.update(ctx, e.GetInstallation(), func(*InstallationRecord) {})
+}
+
+func (r *InstallationRegistry) handleInstallationRepositoriesEvent(ctx context.Context, e *github.InstallationRepositoriesEvent) error {
+	return r.update(ctx, e.GetInstallation(), func(rec *InstallationRecord) {
+		if sel := e.GetRepositorySelection(); sel != "" {
+			rec.RepositorySelection = sel
+		}
+		rec.addRepositories(e.RepositoriesAdded)
+		rec.removeRepositories(e.RepositoriesRemoved)
+	})
+}
+
+// Installations returns the registry of the installations of the GitHub
+// App the bot runs as.
+func (bot *Bot) Installations() *InstallationRegistry {
+	return bot.installations
+}
+
+// SetInstallationStore replaces where the installation registry keeps its
+// records. The default store keeps them in memory. It should be called
+// before the bot starts accepting deliveries.
+func (bot *Bot) SetInstallationStore(store InstallationStore) {
+	bot.installations.mu.Lock()
+	defer bot.installations.mu.Unlock()
+
+	bot.installations.store = store
+}
+
+// ReconcileInstallations replaces the records of the installation
+// registry with the installations and repositories listed by the API.
+// Installations whose repositories cannot be listed, e.g. suspended ones,
+// are logged and keep their records. Run calls it on startup when the bot
+// runs as a GitHub App.
+func (bot *Bot) ReconcileInstallations(ctx context.Context) error {
+	if bot.app == nil {
+		return xerrors.New("reconciling installations needs GitHub App authentication")
+	}
+
+	var installations []*github.Installation
+	opt := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := bot.app.appClient.Apps.ListInstallations(ctx, opt)
+		if err != nil {
+			return xerrors.Errorf("error listing installations: %w", err)
+		}
+		installations = append(installations, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	recs := make([]*InstallationRecord, 0, len(installations))
+	current := map[int64]bool{}
+	for _, inst := range installations {
+		current[inst.GetID()] = true
+		repos, err := bot.installationRepositories(ctx, inst.GetID())
+		if err != nil {
+			bot.logger.Printf("skipping installation %d: %+v", inst.GetID(), err)
+			continue
+		}
+		rec := InstallationRecord{
+			ID:                  inst.GetID(),
+			Account:             inst.GetAccount().GetLogin(),
+			AccountType:         inst.GetAccount().GetType(),
+			RepositorySelection: inst.GetRepositorySelection(),
+			UpdatedAt:           time.Now(),
+		}
+		rec.addRepositories(repos)
+		recs = append(recs, &rec)
+	}
+
+	r := bot.installations
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	known, err := r.store.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rec := range recs {
+		if old, err := r.store.Get(ctx, rec.ID); err == nil {
+			rec.Suspended = old.Suspended
+		}
+		if err := r.store.Save(ctx, rec); err != nil {
+			return err
+		}
+	}
+	for _, rec := range known {
+		if current[rec.ID] {
+			continue
+		}
+		if err := r.store.Delete(ctx, rec.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// installationRepositories lists the repositories the installation can
+// access.
+func (bot *Bot) installationRepositories(ctx context.Context, installationID int64) ([]*github.Repository, error) {
+	client := bot.app.client(installationID)
+	var repos []*github.Repository
+	opt := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.Apps.ListRepos(ctx, opt)
+		if err != nil {
+			return nil, xerrors.Errorf("error listing repositories of installation %d: %w", installationID, err)
+		}
+		repos = append(repos, page...)
+		if resp.NextPage == 0 {
+			return repos, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,326 @@
+package ghbot
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+func TestInstallationEvents(t *testing.T) {
+	bot := New(Config{WebHookSecret: "secret"})
+	ctx := context.Background()
+	registry := bot.Installations()
+
+	// the hooks of the events see the updated registry
+	var hookRepos []string
+	bot.AddInstallationRepositoriesEventHook(func(ctx context.Context, _ *github.InstallationRepositoriesEvent) error {
+		var err error
+		hookRepos, err = registry.Repositories(ctx, 1)
+		return err
+	})
+
+	steps := []struct {
+		event     string
+		payload   string
+		want      *InstallationRecord
+		wantRepos []string
+	}{
+		{
+			event:   "installation",
+			payload: `{"action":"created","installation":{"id":1,"account":{"login":"octocat","type":"User"},"repository_selection":"selected"},"repositories":[{"full_name":"octocat/b"},{"full_name":"octocat/a"}]}`,
+			want:    &InstallationRecord{ID: 1, Account: "octocat", AccountType: "User", RepositorySelection: "selected", Repositories: []string{"octocat/a", "octocat/b"}},
+		},
+		{
+			event:     "installation_repositories",
+			payload:   `{"action":"added","installation":{"id":1},"repository_selection":"selected","repositories_added":[{"full_name":"octocat/c"},{"full_name":"octocat/a"}],"repositories_removed":[]}`,
+			want:      &InstallationRecord{ID: 1, Account: "octocat", AccountType: "User", RepositorySelection: "selected", Repositories: []string{"octocat/a", "octocat/b", "octocat/c"}},
+			wantRepos: []string{"octocat/a", "octocat/b", "octocat/c"},
+		},
+		{
+			event:     "installation_repositories",
+			payload:   `{"action":"removed","installation":{"id":1},"repository_selection":"selected","repositories_added":[],"repositories_removed":[{"full_name":"octocat/b"},{"full_name":"octocat/unknown"}]}`,
+			want:      &InstallationRecord{ID: 1, Account: "octocat", AccountType: "User", RepositorySelection: "selected", Repositories: []string{"octocat/a", "octocat/c"}},
+			wantRepos: []string{"octocat/a", "octocat/c"},
+		},
+		{
+			event:   "installation",
+			payload: `{"action":"suspend","installation":{"id":1}}`,
+			want:    &InstallationRecord{ID: 1, Account: "octocat", AccountType: "User", RepositorySelection: "selected", Suspended: true, Repositories: []string{"octocat/a", "octocat/c"}},
+		},
+		{
+			event:   "installation",
+			payload: `{"action":"unsuspend","installation":{"id":1}}`,
+			want:    &InstallationRecord{ID: 1, Account: "octocat", AccountType: "User", RepositorySelection: "selected", Repositories: []string{"octocat/a", "octocat/c"}},
+		},
+		{
+			event:   "installation",
+			payload: `{"action":"deleted","installation":{"id":1}}`,
+		},
+	}
+	for i, step := range steps {
+		hookRepos = nil
+		if status := deliver(bot, "secret", step.event, step.payload); status != http.StatusOK {
+			t.Fatalf("step %d: status = %d", i, status)
+		}
+		rec, err := registry.Installation(ctx, 1)
+		if step.want == nil {
+			if !xerrors.Is(err, ErrInstallationNotFound) {
+				t.Errorf("step %d: Installation returned %v, %v after deletion", i, rec, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+		if rec.UpdatedAt.IsZero() {
+			t.Errorf("step %d: UpdatedAt was not set", i)
+		}
+		rec.UpdatedAt = time.Time{}
+		if !reflect.DeepEqual(rec, step.want) {
+			t.Errorf("step %d: record = %+v, want %+v", i, rec, step.want)
+		}
+		if step.wantRepos != nil && !reflect.DeepEqual(hookRepos, step.wantRepos) {
+			t.Errorf("step %d: hook saw repositories %v, want %v", i, hookRepos, step.wantRepos)
+		}
+	}
+}
+
+func TestInstallationForRepository(t *testing.T) {
+	bot := New(Config{WebHookSecret: "secret"})
+	ctx := context.Background()
+	for _, payload := range []string{
+		`{"action":"created","installation":{"id":1},"repositories":[{"full_name":"octocat/hello-world"}]}`,
+		`{"action":"created","installation":{"id":2},"repositories":[{"full_name":"acme/widgets"}]}`,
+	} {
+		if status := deliver(bot, "secret", "installation", payload); status != http.StatusOK {
+			t.Fatalf("status = %d", status)
+		}
+	}
+
+	rec, err := bot.Installations().InstallationForRepository(ctx, "Acme/Widgets")
+	if err != nil || rec.ID != 2 {
+		t.Errorf("InstallationForRepository returned %+v, %v, want installation 2", rec, err)
+	}
+	if _, err := bot.Installations().InstallationForRepository(ctx, "acme/other"); !xerrors.Is(err, ErrInstallationNotFound) {
+		t.Errorf("error for an unknown repository = %v", err)
+	}
+	recs, err := bot.Installations().Installations(ctx)
+	if err != nil || len(recs) != 2 || recs[0].ID != 1 || recs[1].ID != 2 {
+		t.Errorf("Installations returned %+v, %v", recs, err)
+	}
+	if _, err := bot.Installations().Repositories(ctx, 3); !xerrors.Is(err, ErrInstallationNotFound) {
+		t.Errorf("error for an unknown installation = %v", err)
+	}
+}
+
+func TestFileInstallationStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ghbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "installations.json")
+	ctx := context.Background()
+
+	store, err := NewFileInstallationStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recs, err := store.List(ctx); err != nil || len(recs) != 0 {
+		t.Errorf("new store holds %+v, %v", recs, err)
+	}
+	updated := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, rec := range []*InstallationRecord{
+		{ID: 1, Account: "octocat", Repositories: []string{"octocat/hello-world"}, UpdatedAt: updated},
+		{ID: 2, Account: "acme", Suspended: true, UpdatedAt: updated},
+		{ID: 3, Account: "other", UpdatedAt: updated},
+	} {
+		if err := store.Save(ctx, rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Delete(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	// a new store reads what the first one wrote
+	reloaded, err := NewFileInstallationStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recs, err := reloaded.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*InstallationRecord{
+		{ID: 1, Account: "octocat", Repositories: []string{"octocat/hello-world"}, UpdatedAt: updated},
+		{ID: 2, Account: "acme", Suspended: true, UpdatedAt: updated},
+	}
+	if !reflect.DeepEqual(recs, want) {
+		t.Errorf("reloaded %+v, want %+v and %+v", recs, *want[0], *want[1])
+	}
+	if _, err := reloaded.Get(ctx, 3); !xerrors.Is(err, ErrInstallationNotFound) {
+		t.Errorf("deleted record was reloaded: %v", err)
+	}
+
+	// no temporary files are left next to the store
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("%d files in the directory of the store", len(files))
+	}
+
+	if err := ioutil.WriteFile(path, []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFileInstallationStore(path); err == nil {
+		t.Error("malformed store was loaded")
+	}
+}
+
+// fakeInstallations serves the installations of an App and the
+// repositories of each, by the token of the installation.
+type fakeInstallations struct {
+	url           string
+	installations string
+	// repositories maps an installation to the pages of its
+	// repositories; a missing one fails with 403.
+	repositories map[int64][]string
+}
+
+func (f *fakeInstallations) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var id int64
+	if _, err := fmt.Sscanf(r.URL.Path, "/api/v3/app/installations/%d/access_tokens", &id); err == nil {
+		w.WriteHeader(http.StatusCreated)
+		expires := time.Now().Add(time.Hour)
+		json.NewEncoder(w).Encode(github.InstallationToken{
+			Token:     github.String(fmt.Sprintf("token-%d", id)),
+			ExpiresAt: &expires,
+		})
+		return
+	}
+	switch r.URL.Path {
+	case "/api/v3/app/installations":
+		fmt.Fprint(w, f.installations)
+	case "/api/v3/installation/repositories":
+		fmt.Sscanf(r.Header.Get("Authorization"), "token token-%d", &id)
+		pages, ok := f.repositories[id]
+		if !ok {
+			http.Error(w, `{"message":"This installation has been suspended"}`, http.StatusForbidden)
+			return
+		}
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
+		}
+		if page < len(pages) {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v3/installation/repositories?page=%d>; rel="next"`, f.url, page+1))
+		}
+		fmt.Fprintf(w, `{"repositories":[%s]}`, pages[page-1])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestReconcileInstallations(t *testing.T) {
+	app := &fakeInstallations{
+		installations: `[{"id":1,"account":{"login":"octocat","type":"User"},"repository_selection":"all"},{"id":2,"account":{"login":"acme","type":"Organization"}},{"id":3,"account":{"login":"other","type":"User"}}]`,
+		repositories: map[int64][]string{
+			1: {`{"full_name":"octocat/b"},{"full_name":"octocat/a"}`, `{"full_name":"octocat/c"}`},
+			3: {``},
+		},
+	}
+	srv := httptest.NewServer(app)
+	defer srv.Close()
+	app.url = srv.URL
+
+	key := appKey(t)
+	bot := New(Config{
+		AppID:             42,
+		PrivateKey:        pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		EnterpriseBaseURL: srv.URL + "/api/v3/",
+	})
+	var logger lineLogger
+	bot.SetLogger(&logger)
+	ctx := context.Background()
+	store := NewMemoryInstallationStore()
+	bot.SetInstallationStore(store)
+	for _, rec := range []*InstallationRecord{
+		{ID: 1, Account: "octocat", Suspended: true, Repositories: []string{"octocat/stale"}},
+		// its repositories cannot be listed, so it keeps its record
+		{ID: 2, Account: "acme", Suspended: true, Repositories: []string{"acme/widgets"}},
+		// it was uninstalled while the bot was down
+		{ID: 4, Account: "gone", Repositories: []string{"gone/repo"}},
+	} {
+		store.Save(ctx, rec)
+	}
+
+	if err := bot.ReconcileInstallations(ctx); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	recs, err := bot.Installations().Installations(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range recs {
+		rec.UpdatedAt = time.Time{}
+	}
+	want := []*InstallationRecord{
+		{ID: 1, Account: "octocat", AccountType: "User", RepositorySelection: "all", Suspended: true, Repositories: []string{"octocat/a", "octocat/b", "octocat/c"}},
+		{ID: 2, Account: "acme", Suspended: true, Repositories: []string{"acme/widgets"}},
+		{ID: 3, Account: "other", AccountType: "User"},
+	}
+	if !reflect.DeepEqual(recs, want) {
+		for i := range recs {
+			t.Errorf("record %d = %+v", i, *recs[i])
+		}
+		t.Errorf("want %+v, %+v and %+v", *want[0], *want[1], *want[2])
+	}
+	if len(logger.lines) != 1 || !strings.HasPrefix(logger.lines[0], "skipping installation 2: error listing repositories of installation 2:") {
+		t.Errorf("logged %q", logger.lines)
+	}
+
+	if err := New(Config{}).ReconcileInstallations(ctx); err == nil {
+		t.Error("bot without App authentication reconciled")
+	}
+}
+
+func TestSetInstallationStoreConcurrently(t *testing.T) {
+	bot := New(Config{WebHookSecret: "secret"})
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			bot.SetInstallationStore(NewMemoryInstallationStore())
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			bot.Installations().Installations(ctx)
+			bot.Installations().Installation(ctx, 1)
+			bot.Installations().Repositories(ctx, 1)
+			bot.Installations().InstallationForRepository(ctx, "octocat/hello-world")
+		}
+	}()
+	wg.Wait()
+}
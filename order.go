@@ -0,0 +1,202 @@
+package ghbot
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+type hookOptions struct {
+	name     string
+	priority int
+	before   []string
+	after    []string
+	dryRun   bool
+}
+
+// HookOption configures a hook at registration.
+type HookOption func(*hookOptions)
+
+// WithName names the hook so that other hooks can be ordered relative to
+// it and so that it can be told apart in HookOrder.
+func WithName(name string) HookOption {
+	return func(opts *hookOptions) {
+		opts.name = name
+	}
+}
+
+// WithPriority sets the priority of the hook. Among hooks which are not
+// constrained by Before or After, ones with higher priority run first and
+// ones with equal priority run in registration order. The default is 0.
+func WithPriority(priority int) HookOption {
+	return func(opts *hookOptions) {
+		opts.priority = priority
+	}
+}
+
+// Before makes the hook run before the named hooks of the same event type.
+func Before(names ...string) HookOption {
+	return func(opts *hookOptions) {
+		opts.before = append(opts.before, names...)
+	}
+}
+
+// After makes the hook run after the named hooks of the same event type.
+func After(names ...string) HookOption {
+	return func(opts *hookOptions) {
+		opts.after = append(opts.after, names...)
+	}
+}
+
+func (e *hookEntry) String() string {
+	if e.name != "" {
+		return e.name
+	}
+	return "#" + strconv.FormatUint(e.id, 10)
+}
+
+// resolve computes the order the registered hooks are run in. When the
+// constraints cannot be satisfied the registration order is kept and the
+// problem is logged by the bot and reported by Bot.Validate.
+func (l *hookList) resolve() {
+	entries, err := resolveHookOrder(l.registered)
+	if err != nil {
+		l.entries = l.registered
+		l.err = err
+		return
+	}
+	l.entries = entries
+	l.err = nil
+}
+
+func resolveHookOrder(registered []*hookEntry) ([]*hookEntry, error) {
+	byName := map[string]int{}
+	for i, e := range registered {
+		if e.name == "" {
+			continue
+		}
+		if _, ok := byName[e.name]; ok {
+			return nil, xerrors.Errorf("duplicate hook name: %s", e.name)
+		}
+		byName[e.name] = i
+	}
+
+	// edges[i] holds the hooks which must run after registered[i]
+	edges := make([][]int, len(registered))
+	indegree := make([]int, len(registered))
+	addEdge := func(from, to int) {
+		edges[from] = append(edges[from], to)
+		indegree[to]++
+	}
+	for i, e := range registered {
+		for _, name := range e.before {
+			j, ok := byName[name]
+			if !ok {
+				return nil, xerrors.Errorf("hook %s must run before unknown hook %s", e, name)
+			}
+			addEdge(i, j)
+		}
+		for _, name := range e.after {
+			j, ok := byName[name]
+			if !ok {
+				return nil, xerrors.Errorf("hook %s must run after unknown hook %s", e, name)
+			}
+			addEdge(j, i)
+		}
+	}
+
+	var ready []int
+	for i := range registered {
+		if indegree[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+	entries := make([]*hookEntry, 0, len(registered))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(a, b int) bool {
+			ea, eb := registered[ready[a]], registered[ready[b]]
+			if ea.priority != eb.priority {
+				return ea.priority > eb.priority
+			}
+			return ready[a] < ready[b]
+		})
+		i := ready[0]
+		ready = ready[1:]
+		entries = append(entries, registered[i])
+		for _, j := range edges[i] {
+			indegree[j]--
+			if indegree[j] == 0 {
+				ready = append(ready, j)
+			}
+		}
+	}
+	if len(entries) != len(registered) {
+		var cycle []string
+		for i, e := range registered {
+			if indegree[i] > 0 {
+				cycle = append(cycle, e.String())
+			}
+		}
+		return nil, xerrors.Errorf("hook order has a cycle among: %s", strings.Join(cycle, ", "))
+	}
+	return entries, nil
+}
+
+// Validate reports errors in the Config given to New, and hooks whose
+// ordering constraints cannot be satisfied: duplicate names, references
+// to unknown hooks and cycles. Run calls it before accepting deliveries.
+func (bot *Bot) Validate() error {
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+
+	if bot.configErr != nil {
+		return bot.configErr
+	}
+
+	if err := bot.anyEventHooks.err; err != nil {
+		return xerrors.Errorf("any event hooks: %w", err)
+	}
+	for _, eventType := range sortedKeys(bot.rawEventHooks) {
+		if err := bot.rawEventHooks[eventType].err; err != nil {
+			return xerrors.Errorf("raw %s hooks: %w", eventType, err)
+		}
+	}
+	for _, eventType := range sortedKeys(bot.eventHooks) {
+		if err := bot.eventHooks[eventType].err; err != nil {
+			return xerrors.Errorf("%s hooks: %w", eventType, err)
+		}
+	}
+	return nil
+}
+
+// HookOrder returns the hooks registered for the event type in the order
+// they are run. Unnamed hooks are shown as "#" followed by their
+// registration number.
+func (bot *Bot) HookOrder(eventType string) ([]string, error) {
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+
+	list, ok := bot.eventHooks[eventType]
+	if !ok {
+		return nil, nil
+	}
+	if list.err != nil {
+		return nil, list.err
+	}
+	order := make([]string, 0, len(list.entries))
+	for _, e := range list.entries {
+		order = append(order, e.String())
+	}
+	return order, nil
+}
+
+func sortedKeys(m map[string]*hookList) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
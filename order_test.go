@@ -0,0 +1,176 @@
+package ghbot
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+)
+
+func TestHookOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		hooks [][]HookOption
+		want  string
+	}{
+		{
+			name:  "registration order",
+			hooks: [][]HookOption{nil, {WithName("b")}, nil},
+			want:  "#1, b, #3",
+		},
+		{
+			name:  "higher priority first",
+			hooks: [][]HookOption{{WithName("low"), WithPriority(-1)}, {WithName("default")}, {WithName("high"), WithPriority(10)}, {WithName("mid"), WithPriority(5)}},
+			want:  "high, mid, default, low",
+		},
+		{
+			name:  "equal priority in registration order",
+			hooks: [][]HookOption{{WithName("a"), WithPriority(1)}, {WithName("b")}, {WithName("c"), WithPriority(1)}},
+			want:  "a, c, b",
+		},
+		{
+			name:  "before",
+			hooks: [][]HookOption{{WithName("a")}, {WithName("b"), Before("a")}},
+			want:  "b, a",
+		},
+		{
+			name:  "after",
+			hooks: [][]HookOption{{WithName("a"), After("b")}, {WithName("b")}},
+			want:  "b, a",
+		},
+		{
+			name:  "after a hook registered later",
+			hooks: [][]HookOption{{WithName("a"), After("c")}, {WithName("b")}, {WithName("c")}},
+			want:  "b, c, a",
+		},
+		{
+			name:  "constraints win over priority",
+			hooks: [][]HookOption{{WithName("a"), WithPriority(10), After("b")}, {WithName("b"), WithPriority(-10)}},
+			want:  "b, a",
+		},
+		{
+			name:  "chain",
+			hooks: [][]HookOption{{WithName("c"), After("b")}, {WithName("a"), Before("b")}, {WithName("b")}},
+			want:  "a, b, c",
+		},
+		{
+			name:  "priority among ready hooks",
+			hooks: [][]HookOption{{WithName("a")}, {WithName("b"), After("a")}, {WithName("c"), WithPriority(1)}},
+			want:  "c, a, b",
+		},
+	}
+	for _, tt := range tests {
+		bot := New(Config{})
+		for _, opts := range tt.hooks {
+			bot.AddPushEventHook(func(context.Context, *github.PushEvent) error { return nil }, opts...)
+		}
+		order, err := bot.HookOrder("push")
+		if err != nil {
+			t.Errorf("%s: %+v", tt.name, err)
+			continue
+		}
+		if got := strings.Join(order, ", "); got != tt.want {
+			t.Errorf("%s: order = %s, want %s", tt.name, got, tt.want)
+		}
+		if err := bot.Validate(); err != nil {
+			t.Errorf("%s: Validate returned %+v", tt.name, err)
+		}
+	}
+}
+
+func TestHookOrderErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		hooks [][]HookOption
+		want  string
+	}{
+		{
+			name:  "duplicate name",
+			hooks: [][]HookOption{{WithName("a")}, {WithName("a")}},
+			want:  "duplicate hook name: a",
+		},
+		{
+			name:  "before unknown hook",
+			hooks: [][]HookOption{{WithName("a"), Before("b")}},
+			want:  "hook a must run before unknown hook b",
+		},
+		{
+			name:  "after unknown hook",
+			hooks: [][]HookOption{nil, {After("b")}},
+			want:  "hook #2 must run after unknown hook b",
+		},
+		{
+			name:  "cycle",
+			hooks: [][]HookOption{{WithName("a"), After("c")}, {WithName("b"), After("a")}, {WithName("c"), After("b")}, {WithName("d")}},
+			want:  "hook order has a cycle among: a, b, c",
+		},
+		{
+			name:  "self reference",
+			hooks: [][]HookOption{{WithName("a"), Before("a")}},
+			want:  "hook order has a cycle among: a",
+		},
+	}
+	for _, tt := range tests {
+		bot := New(Config{})
+		var logger lineLogger
+		bot.SetLogger(&logger)
+		var ran []int
+		for i, opts := range tt.hooks {
+			i := i
+			bot.AddPushEventHook(func(context.Context, *github.PushEvent) error {
+				ran = append(ran, i)
+				return nil
+			}, opts...)
+		}
+
+		if _, err := bot.HookOrder("push"); err == nil || err.Error() != tt.want {
+			t.Errorf("%s: HookOrder returned %v, want %s", tt.name, err, tt.want)
+		}
+		if err := bot.Validate(); err == nil || err.Error() != "push hooks: "+tt.want {
+			t.Errorf("%s: Validate returned %v", tt.name, err)
+		}
+		if n := len(logger.lines); n == 0 || !strings.HasPrefix(logger.lines[n-1], "hooks run in registration order: "+tt.want+":") {
+			t.Errorf("%s: logged %q", tt.name, logger.lines)
+		}
+
+		// the hooks still run, in registration order
+		if err := bot.handleWebHookPayload(context.Background(), "push", []byte(`{}`)); err != nil {
+			t.Fatal(err)
+		}
+		for i := range tt.hooks {
+			if len(ran) != len(tt.hooks) || ran[i] != i {
+				t.Errorf("%s: ran %v", tt.name, ran)
+				break
+			}
+		}
+	}
+}
+
+func TestHookOrderResolvedByRemove(t *testing.T) {
+	bot := New(Config{})
+	var logger lineLogger
+	bot.SetLogger(&logger)
+	noop := func(context.Context, *github.PushEvent) error { return nil }
+	bot.AddPushEventHook(noop, WithName("a"))
+	h := bot.AddPushEventHook(noop, WithName("a"))
+	if err := bot.Validate(); err == nil {
+		t.Fatal("duplicate name was not reported")
+	}
+	h.Remove()
+	if err := bot.Validate(); err != nil {
+		t.Errorf("Validate returned %+v after removing the duplicate", err)
+	}
+
+	// removing a hook another one refers to breaks the order
+	h = bot.AddPushEventHook(noop, WithName("b"))
+	bot.AddPushEventHook(noop, After("b"))
+	logged := len(logger.lines)
+	h.Remove()
+	if err := bot.Validate(); err == nil {
+		t.Error("reference to the removed hook was not reported")
+	}
+	if len(logger.lines) != logged+1 {
+		t.Errorf("removal was not logged: %q", logger.lines)
+	}
+}
@@ -0,0 +1,410 @@
+package ghbot
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+const (
+	defaultPollInterval = time.Minute
+	// the Events API returns at most 300 events of a source
+	maxEventPages = 3
+)
+
+// PollCheckpoint is where a Poller stopped reading the events of a
+// source.
+type PollCheckpoint struct {
+	// ETag is the entity tag of the newest events, sent back as
+	// If-None-Match so that unchanged sources cost no rate limit.
+	ETag string `json:"etag,omitempty"`
+	// LastEventID is the ID of the newest event handled.
+	LastEventID int64 `json:"last_event_id"`
+}
+
+// PollCheckpointStore persists the checkpoints of a Poller by source, e.g.
+// "repos/octocat/hello-world" or "orgs/octo-org".
+type PollCheckpointStore interface {
+	// Get returns nil without an error when the source has not been
+	// polled yet.
+	Get(ctx context.Context, source string) (*PollCheckpoint, error)
+	Save(ctx context.Context, source string, cp *PollCheckpoint) error
+}
+
+// MemoryPollCheckpointStore keeps checkpoints in memory only.
+type MemoryPollCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]PollCheckpoint
+}
+
+func NewMemoryPollCheckpointStore() *MemoryPollCheckpointStore {
+	return &MemoryPollCheckpointStore{
+		checkpoints: map[string]PollCheckpoint{},
+	}
+}
+
+func (s *MemoryPollCheckpointStore) Get(_ context.Context, source string) (*PollCheckpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cp, ok := s.checkpoints[source]
+	if !ok {
+		return nil, nil
+	}
+	return &cp, nil
+}
+
+func (s *MemoryPollCheckpointStore) Save(_ context.Context, source string, cp *PollCheckpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoints[source] = *cp
+	return nil
+}
+
+// FilePollCheckpointStore keeps checkpoints in memory and writes all of
+// them to a JSON file on every change.
+type FilePollCheckpointStore struct {
+	MemoryPollCheckpointStore
+	path string
+}
+
+// NewFilePollCheckpointStore loads the checkpoints in the file at path, if
+// it exists.
+func NewFilePollCheckpointStore(path string) (*FilePollCheckpointStore, error) {
+	s := FilePollCheckpointStore{
+		MemoryPollCheckpointStore: MemoryPollCheckpointStore{
+			checkpoints: map[string]PollCheckpoint{},
+		},
+		path: path,
+	}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &s, nil
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("error reading checkpoint store: %w", err)
+	}
+	if err := json.Unmarshal(b, &s.checkpoints); err != nil {
+		return nil, xerrors.Errorf("error parsing checkpoint store: %w", err)
+	}
+	return &s, nil
+}
+
+func (s *FilePollCheckpointStore) Save(ctx context.Context, source string, cp *PollCheckpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoints[source] = *cp
+	b, err := json.MarshalIndent(s.checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(s.path, b); err != nil {
+		return xerrors.Errorf("error writing checkpoint store: %w", err)
+	}
+	return nil
+}
+
+// PollOptions selects the sources a Poller reads events from.
+type PollOptions struct {
+	// Repos are repositories, written as "owner/name".
+	Repos []string
+	// Orgs are organizations, whose events cover all their public
+	// repositories.
+	Orgs []string
+	// Checkpoints is where the poller remembers the events it handled.
+	// They are kept in memory when nil.
+	Checkpoints PollCheckpointStore
+	// Interval is the time between polls of a source. GitHub asks for a
+	// longer one with X-Poll-Interval, which is obeyed. Defaults to a
+	// minute.
+	Interval time.Duration
+}
+
+// Poller feeds events read from the Events API to the hooks of a bot, for
+// bots which cannot receive webhooks. The events are mapped to their
+// webhook event types, e.g. IssueCommentEvent to "issue_comment", and get
+// the repository, organization and sender filled in, which the Events API
+// puts beside the payload. Webhooks carry more than the Events API,
+// though, and not every event type is listed by it.
+type Poller struct {
+	bot         *Bot
+	sources     []string
+	checkpoints PollCheckpointStore
+	interval    time.Duration
+
+	mu   sync.Mutex
+	next map[string]time.Time
+}
+
+func NewPoller(bot *Bot, opts PollOptions) *Poller {
+	p := Poller{
+		bot:         bot,
+		checkpoints: opts.Checkpoints,
+		interval:    opts.Interval,
+		next:        map[string]time.Time{},
+	}
+	for _, repo := range opts.Repos {
+		p.sources = append(p.sources, "repos/"+repo)
+	}
+	for _, org := range opts.Orgs {
+		p.sources = append(p.sources, "orgs/"+org)
+	}
+	if p.checkpoints == nil {
+		p.checkpoints = NewMemoryPollCheckpointStore()
+	}
+	if p.interval <= 0 {
+		p.interval = defaultPollInterval
+	}
+	return &p
+}
+
+// Run polls until ctx is done, each source as often as allowed. Errors are
+// logged and the source is polled again after the interval.
+func (p *Poller) Run(ctx context.Context) error {
+	if err := p.bot.Validate(); err != nil {
+		return xerrors.Errorf("invalid configuration: %w", err)
+	}
+	for {
+		now := time.Now()
+		wait := p.interval
+		for _, source := range p.sources {
+			p.mu.Lock()
+			next := p.next[source]
+			p.mu.Unlock()
+			if !next.After(now) {
+				if err := p.pollSource(ctx, source); err != nil {
+					p.bot.logger.Printf("error polling %s: %+v", source, err)
+				}
+				p.mu.Lock()
+				next = p.next[source]
+				p.mu.Unlock()
+			}
+			if d := next.Sub(now); d < wait {
+				wait = d
+			}
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// PollOnce polls every source once, regardless of the interval, and
+// returns the first error.
+//
+// The first poll of a source only checkpoints its newest event, so that
+// the history is not handled again.
+func (p *Poller) PollOnce(ctx context.Context) error {
+	var first error
+	for _, source := range p.sources {
+		if err := p.pollSource(ctx, source); err != nil && first == nil {
+			first = xerrors.Errorf("error polling %s: %w", source, err)
+		}
+	}
+	return first
+}
+
+func (p *Poller) pollSource(ctx context.Context, source string) error {
+	p.mu.Lock()
+	p.next[source] = time.Now().Add(p.interval)
+	p.mu.Unlock()
+
+	client, err := p.client(ctx, source)
+	if err != nil {
+		return err
+	}
+	cp, err := p.checkpoints.Get(ctx, source)
+	if err != nil {
+		return xerrors.Errorf("error reading checkpoint: %w", err)
+	}
+	initial := cp == nil
+	if initial {
+		cp = &PollCheckpoint{}
+	}
+
+	var events []*github.Event
+	var etag string
+	for page := 1; page <= maxEventPages; page++ {
+		req, err := client.NewRequest(http.MethodGet, source+"/events?per_page=100&page="+strconv.Itoa(page), nil)
+		if err != nil {
+			return err
+		}
+		if page == 1 && cp.ETag != "" {
+			req.Header.Set("If-None-Match", cp.ETag)
+		}
+		var list []*github.Event
+		resp, err := client.Do(ctx, req, &list)
+		if page == 1 && resp != nil {
+			p.obeyPollInterval(source, resp.Header)
+			if resp.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			etag = resp.Header.Get("ETag")
+		}
+		if err != nil {
+			return xerrors.Errorf("error listing events: %w", err)
+		}
+		seen := false
+		for _, e := range list {
+			if eventID(e) <= cp.LastEventID {
+				seen = true
+				break
+			}
+			events = append(events, e)
+		}
+		if seen || initial || resp.NextPage == 0 {
+			break
+		}
+	}
+	if initial && len(events) > 0 {
+		cp.LastEventID = eventID(events[0])
+		events = nil
+	}
+
+	// the Events API lists the newest first
+	sort.SliceStable(events, func(i, j int) bool { return eventID(events[i]) < eventID(events[j]) })
+	for _, e := range events {
+		typ := webHookEventType(e.GetType())
+		payload, err := eventPayload(e)
+		if err != nil {
+			p.bot.logger.Printf("error reading event %s: %+v", e.GetID(), err)
+		} else if err := p.bot.handleWebHookPayload(WithClient(ctx, client), typ, payload); err != nil {
+			p.bot.logger.Printf("error handling event %s: %+v", e.GetID(), err)
+		}
+		cp.LastEventID = eventID(e)
+		if err := p.checkpoints.Save(ctx, source, cp); err != nil {
+			return xerrors.Errorf("error saving checkpoint: %w", err)
+		}
+	}
+	cp.ETag = etag
+	if err := p.checkpoints.Save(ctx, source, cp); err != nil {
+		return xerrors.Errorf("error saving checkpoint: %w", err)
+	}
+	return nil
+}
+
+func (p *Poller) obeyPollInterval(source string, header http.Header) {
+	seconds, err := strconv.Atoi(header.Get("X-Poll-Interval"))
+	if err != nil {
+		return
+	}
+	if d := time.Duration(seconds) * time.Second; d > p.interval {
+		p.mu.Lock()
+		p.next[source] = time.Now().Add(d)
+		p.mu.Unlock()
+	}
+}
+
+// client returns a client of the installation which can access the
+// source, if the bot knows one, or else of the bot's client factory with
+// installation ID 0.
+func (p *Poller) client(ctx context.Context, source string) (*github.Client, error) {
+	var installationID int64
+	recs, err := p.bot.Installations().Installations(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("error listing installations: %w", err)
+	}
+	if org := strings.TrimPrefix(source, "orgs/"); org != source {
+		for _, rec := range recs {
+			if strings.EqualFold(rec.Account, org) {
+				installationID = rec.ID
+			}
+		}
+	} else if rec, err := p.bot.Installations().InstallationForRepository(ctx, strings.TrimPrefix(source, "repos/")); err == nil {
+		installationID = rec.ID
+	}
+
+	p.bot.mu.Lock()
+	factory := p.bot.clientFactory
+	p.bot.mu.Unlock()
+	if factory == nil {
+		return nil, xerrors.New("no GitHub client is configured")
+	}
+	client, err := factory(ctx, installationID)
+	if err != nil {
+		return nil, xerrors.Errorf("error creating GitHub client: %w", err)
+	}
+	return client, nil
+}
+
+func eventID(e *github.Event) int64 {
+	id, _ := strconv.ParseInt(e.GetID(), 10, 64)
+	return id
+}
+
+// webHookEventType returns the webhook event type of an Events API event
+// type, e.g. "pull_request_review_comment" for
+// "PullRequestReviewCommentEvent".
+func webHookEventType(typ string) string {
+	var b strings.Builder
+	for i, r := range strings.TrimSuffix(typ, "Event") {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// eventPayload returns the payload of the event with the repository,
+// organization and sender filled in, as webhooks deliver them.
+func eventPayload(e *github.Event) ([]byte, error) {
+	var payload map[string]json.RawMessage
+	if e.RawPayload != nil {
+		if err := json.Unmarshal(*e.RawPayload, &payload); err != nil {
+			return nil, xerrors.Errorf("%s: %v: %w", e.GetType(), err, errInvalidPayload)
+		}
+	}
+	if payload == nil {
+		payload = map[string]json.RawMessage{}
+	}
+	fill := func(key string, v interface{}) {
+		if _, ok := payload[key]; ok {
+			return
+		}
+		if b, err := json.Marshal(v); err == nil {
+			payload[key] = b
+		}
+	}
+	if repo := e.GetRepo(); repo.GetName() != "" {
+		fullName := repo.GetName()
+		owner, name := "", fullName
+		if i := strings.Index(fullName, "/"); i >= 0 {
+			owner, name = fullName[:i], fullName[i+1:]
+		}
+		fill("repository", &github.Repository{
+			ID:       repo.ID,
+			Name:     github.String(name),
+			FullName: github.String(fullName),
+			Owner:    &github.User{Login: github.String(owner)},
+			URL:      repo.URL,
+		})
+	}
+	if e.Org != nil {
+		fill("organization", e.Org)
+	}
+	if e.Actor != nil {
+		fill("sender", e.Actor)
+	}
+	return json.Marshal(payload)
+}
@@ -0,0 +1,138 @@
+package ghbot_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+	"github.com/nasa9084/ghbot"
+	"github.com/nasa9084/ghbot/fakegithub"
+)
+
+// statusRecorder records the status of every response.
+type statusRecorder struct {
+	mu       sync.Mutex
+	statuses []int
+}
+
+func (r *statusRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statuses = append(r.statuses, resp.StatusCode)
+	return resp, nil
+}
+
+func (r *statusRecorder) take() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	statuses := r.statuses
+	r.statuses = nil
+	return statuses
+}
+
+func TestPoller(t *testing.T) {
+	srv := fakegithub.NewServer()
+	defer srv.Close()
+	dir, err := ioutil.TempDir("", "ghbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var recorder statusRecorder
+	client := github.NewClient(&http.Client{Transport: &recorder})
+	client.BaseURL, _ = url.Parse(srv.URL)
+
+	var handled []int
+	bot := ghbot.New(ghbot.Config{})
+	bot.SetClientFactory(func(context.Context, int64) (*github.Client, error) { return client, nil })
+	bot.AddIssuesEventHook(func(_ context.Context, e *github.IssuesEvent) error {
+		if e.GetRepo().GetFullName() != "octocat/hello-world" || e.GetSender().GetLogin() == "" {
+			t.Errorf("event of %s by %q", e.GetRepo().GetFullName(), e.GetSender().GetLogin())
+		}
+		handled = append(handled, e.GetIssue().GetNumber())
+		return nil
+	})
+	addIssue := func(number int) string {
+		return srv.AddEvent("octocat/hello-world", "IssuesEvent", map[string]interface{}{
+			"action": "opened",
+			"issue":  map[string]int{"number": number},
+		})
+	}
+	newPoller := func() (*ghbot.Poller, *ghbot.FilePollCheckpointStore) {
+		store, err := ghbot.NewFilePollCheckpointStore(filepath.Join(dir, "checkpoints.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ghbot.NewPoller(bot, ghbot.PollOptions{Repos: []string{"octocat/hello-world"}, Checkpoints: store}), store
+	}
+	checkpoint := func(store ghbot.PollCheckpointStore) *ghbot.PollCheckpoint {
+		cp, err := store.Get(context.Background(), "repos/octocat/hello-world")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cp
+	}
+	poll := func(p *ghbot.Poller, wantStatus int, wantHandled ...int) {
+		t.Helper()
+		handled = nil
+		if err := p.PollOnce(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if statuses := recorder.take(); len(statuses) != 1 || statuses[0] != wantStatus {
+			t.Errorf("answered with %v, want [%d]", statuses, wantStatus)
+		}
+		if !reflect.DeepEqual(handled, wantHandled) {
+			t.Errorf("handled issues %v, want %v", handled, wantHandled)
+		}
+	}
+
+	addIssue(1)
+	newest := addIssue(2)
+	p, store := newPoller()
+
+	// the first poll only checkpoints the history
+	poll(p, http.StatusOK)
+	cp := checkpoint(store)
+	if cp == nil || cp.ETag == "" {
+		t.Fatalf("checkpoint = %+v after the first poll", cp)
+	}
+	if id := strconv.FormatInt(cp.LastEventID, 10); id != newest {
+		t.Errorf("checkpoint at event %s, want %s", id, newest)
+	}
+
+	// unchanged sources are answered from the ETag
+	poll(p, http.StatusNotModified)
+
+	// new events are handled oldest first, once
+	addIssue(3)
+	newest = addIssue(4)
+	poll(p, http.StatusOK, 3, 4)
+	if id := strconv.FormatInt(checkpoint(store).LastEventID, 10); id != newest {
+		t.Errorf("checkpoint at event %s, want %s", id, newest)
+	}
+	poll(p, http.StatusNotModified)
+
+	// and not again after a restart
+	saved := checkpoint(store)
+	p, store = newPoller()
+	if got := checkpoint(store); !reflect.DeepEqual(got, saved) {
+		t.Errorf("checkpoint = %+v after reloading, want %+v", got, saved)
+	}
+	poll(p, http.StatusNotModified)
+	addIssue(5)
+	poll(p, http.StatusOK, 5)
+}
@@ -0,0 +1,463 @@
+package ghbot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"golang.org/x/xerrors"
+)
+
+// defaultVisibilityTimeout is how long a dequeued delivery may stay
+// unacknowledged before it is handed out again.
+const defaultVisibilityTimeout = 5 * time.Minute
+
+// defaultMaxDeliveryAttempts is how often RunWorker handles a delivery
+// which is never acknowledged, e.g. because it crashes its worker.
+const defaultMaxDeliveryAttempts = 5
+
+// ErrMalformedDelivery is wrapped by the error a Transport returns from
+// Dequeue for a message which cannot be decoded. The message is dropped,
+// and RunWorker logs the error and goes on with the next one.
+var ErrMalformedDelivery = xerrors.New("malformed delivery")
+
+// Transport queues webhook deliveries between the receiver, which checks
+// their signatures and enqueues them, and workers, which handle them. See
+// SetTransport and RunWorker.
+//
+// A delivery which is dequeued but not acknowledged within the visibility
+// timeout of the transport, e.g. because its worker crashed, is dequeued
+// again. Ack ignores a delivery which was dequeued again meanwhile, so
+// that a late worker does not remove the claim of the next one; the
+// spool and Redis transports check this before removing the delivery,
+// not atomically with it, so an Ack racing the redelivery may still
+// remove it.
+type Transport interface {
+	Enqueue(ctx context.Context, d *RecordedDelivery) error
+	// Dequeue blocks until a delivery is available or ctx is done.
+	Dequeue(ctx context.Context) (*QueuedDelivery, error)
+	Ack(ctx context.Context, q *QueuedDelivery) error
+}
+
+// QueuedDelivery is a delivery taken from a Transport.
+type QueuedDelivery struct {
+	Delivery *RecordedDelivery
+	// MessageID identifies the delivery in the queue.
+	MessageID string
+	// Attempts is how often the delivery has been dequeued, including
+	// this time. It is more than one after a worker failed to
+	// acknowledge it.
+	Attempts int
+}
+
+// SetTransport makes the bot enqueue the deliveries it receives with a
+// valid signature into t and answer 202 Accepted, instead of handling
+// them. Workers handle them with RunWorker. nil makes the bot handle
+// deliveries itself again.
+func (bot *Bot) SetTransport(t Transport) {
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+
+	bot.queue = t
+}
+
+// SetMaxDeliveryAttempts makes RunWorker drop a delivery dequeued more
+// than n times, instead of handling it again, which is 5 by default. n
+// of 0 never drops deliveries.
+func (bot *Bot) SetMaxDeliveryAttempts(n int) {
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+
+	bot.maxAttempts = n
+}
+
+func (bot *Bot) enqueueDelivery(w http.ResponseWriter, r *http.Request, receivedAt time.Time, t Transport) {
+	body, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if _, err := github.ValidatePayload(r, bot.webhookSecret); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := t.Enqueue(r.Context(), newRecordedDelivery(r, body, receivedAt)); err != nil {
+		bot.logger.Printf("error enqueueing delivery: %+v", err)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// RunWorker handles deliveries dequeued from t until ctx is done, as the
+// bot would have handled them when they were received. A delivery is
+// acknowledged after its hooks ran, even when one of them failed, which
+// is logged; only deliveries whose worker went away are handed out again,
+// up to the limit of SetMaxDeliveryAttempts. A delivery being handled
+// when ctx is done is not acknowledged, as its hooks may have been cut
+// short. RunWorker returns early only when t fails to dequeue, e.g.
+// because its connection broke; malformed deliveries are logged and
+// skipped.
+func (bot *Bot) RunWorker(ctx context.Context, t Transport) error {
+	if err := bot.Validate(); err != nil {
+		return xerrors.Errorf("invalid configuration: %w", err)
+	}
+	for {
+		q, err := t.Dequeue(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if xerrors.Is(err, ErrMalformedDelivery) {
+				bot.logger.Printf("dropping delivery: %+v", err)
+				continue
+			}
+			return xerrors.Errorf("error dequeueing delivery: %w", err)
+		}
+		bot.mu.Lock()
+		maxAttempts := bot.maxAttempts
+		bot.mu.Unlock()
+		if maxAttempts > 0 && q.Attempts > maxAttempts {
+			bot.logger.Printf("dropping delivery %s after %d attempts", q.Delivery.ID, q.Attempts-1)
+		} else {
+			if err := bot.handleRecordedDelivery(ctx, q.Delivery); err != nil {
+				bot.logger.Printf("error handling delivery %s: %+v", q.Delivery.ID, err)
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+		}
+		if err := t.Ack(ctx, q); err != nil {
+			bot.logger.Printf("error acknowledging delivery %s: %+v", q.Delivery.ID, err)
+		}
+	}
+}
+
+func marshalDelivery(d *RecordedDelivery) ([]byte, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return nil, xerrors.Errorf("error encoding delivery: %w", err)
+	}
+	return b, nil
+}
+
+func unmarshalDelivery(b []byte) (*RecordedDelivery, error) {
+	var d RecordedDelivery
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, xerrors.Errorf("error decoding delivery: %v: %w", err, ErrMalformedDelivery)
+	}
+	return &d, nil
+}
+
+// MemoryTransport queues deliveries in memory, between the receiver and
+// workers running in the same process.
+type MemoryTransport struct {
+	timeout time.Duration
+	notify  chan struct{}
+
+	mu       sync.Mutex
+	lastID   int64
+	ready    []*memoryMessage
+	inflight map[string]*memoryMessage
+}
+
+type memoryMessage struct {
+	id       string
+	delivery []byte
+	attempts int
+	deadline time.Time
+}
+
+// NewMemoryTransport returns a transport handing out unacknowledged
+// deliveries again after visibilityTimeout, or five minutes when it is 0.
+func NewMemoryTransport(visibilityTimeout time.Duration) *MemoryTransport {
+	if visibilityTimeout <= 0 {
+		visibilityTimeout = defaultVisibilityTimeout
+	}
+	return &MemoryTransport{
+		timeout:  visibilityTimeout,
+		notify:   make(chan struct{}, 1),
+		inflight: map[string]*memoryMessage{},
+	}
+}
+
+func (t *MemoryTransport) Enqueue(_ context.Context, d *RecordedDelivery) error {
+	b, err := marshalDelivery(d)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.lastID++
+	t.ready = append(t.ready, &memoryMessage{id: strconv.FormatInt(t.lastID, 10), delivery: b})
+	t.mu.Unlock()
+
+	select {
+	case t.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (t *MemoryTransport) Dequeue(ctx context.Context) (*QueuedDelivery, error) {
+	for {
+		msg, wait := t.take(time.Now())
+		if msg != nil {
+			d, err := unmarshalDelivery(msg.delivery)
+			if err != nil {
+				t.Ack(ctx, &QueuedDelivery{MessageID: msg.id, Attempts: msg.attempts})
+				return nil, xerrors.Errorf("message %s: %w", msg.id, err)
+			}
+			return &QueuedDelivery{Delivery: d, MessageID: msg.id, Attempts: msg.attempts}, nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-t.notify:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// take returns the next delivery, or how long to wait until an
+// unacknowledged one expires.
+func (t *MemoryTransport) take(now time.Time) (*memoryMessage, time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	wait := t.timeout
+	var expired []*memoryMessage
+	for id, msg := range t.inflight {
+		if !msg.deadline.After(now) {
+			expired = append(expired, msg)
+			delete(t.inflight, id)
+		} else if d := msg.deadline.Sub(now); d < wait {
+			wait = d
+		}
+	}
+	// redelivered ones go first, in the order they were enqueued
+	sort.Slice(expired, func(i, j int) bool {
+		a, _ := strconv.ParseInt(expired[i].id, 10, 64)
+		b, _ := strconv.ParseInt(expired[j].id, 10, 64)
+		return a < b
+	})
+	t.ready = append(expired, t.ready...)
+	if len(t.ready) == 0 {
+		return nil, wait
+	}
+	msg := t.ready[0]
+	t.ready = t.ready[1:]
+	msg.attempts++
+	msg.deadline = now.Add(t.timeout)
+	t.inflight[msg.id] = msg
+	return msg, 0
+}
+
+func (t *MemoryTransport) Ack(_ context.Context, q *QueuedDelivery) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if msg, ok := t.inflight[q.MessageID]; ok && msg.attempts == q.Attempts {
+		delete(t.inflight, q.MessageID)
+	}
+	return nil
+}
+
+// spoolPollInterval is how often a SpoolTransport looks for new files
+// while waiting.
+const spoolPollInterval = 200 * time.Millisecond
+
+// SpoolTransport queues deliveries as files in a directory, which may be
+// shared by processes on the same host or over a network file system
+// with atomic renames. New deliveries are written to "new", claimed by
+// renaming them to "cur" and removed when acknowledged.
+type SpoolTransport struct {
+	dir     string
+	timeout time.Duration
+
+	mu     sync.Mutex
+	lastID int64
+}
+
+type spoolEntry struct {
+	// Attempts counts the times the delivery was dequeued before.
+	Attempts int               `json:"attempts"`
+	Delivery *RecordedDelivery `json:"delivery"`
+}
+
+// NewSpoolTransport creates the spool directory dir if it does not exist.
+// Unacknowledged deliveries are handed out again after
+// visibilityTimeout, or five minutes when it is 0.
+func NewSpoolTransport(dir string, visibilityTimeout time.Duration) (*SpoolTransport, error) {
+	for _, sub := range []string{"new", "cur", "tmp"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0700); err != nil {
+			return nil, xerrors.Errorf("error creating spool directory: %w", err)
+		}
+	}
+	if visibilityTimeout <= 0 {
+		visibilityTimeout = defaultVisibilityTimeout
+	}
+	return &SpoolTransport{dir: dir, timeout: visibilityTimeout}, nil
+}
+
+// write puts entry into new under name, through tmp so that it never
+// appears partially written.
+func (t *SpoolTransport) write(name string, entry spoolEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return xerrors.Errorf("error encoding delivery: %w", err)
+	}
+	tmp := filepath.Join(t.dir, "tmp", name)
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return xerrors.Errorf("error writing spool: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(t.dir, "new", name)); err != nil {
+		os.Remove(tmp)
+		return xerrors.Errorf("error writing spool: %w", err)
+	}
+	return nil
+}
+
+func (t *SpoolTransport) Enqueue(_ context.Context, d *RecordedDelivery) error {
+	t.mu.Lock()
+	t.lastID++
+	seq := t.lastID
+	t.mu.Unlock()
+
+	// names sort in the order deliveries were enqueued
+	name := fmt.Sprintf("%020d-%d-%d.json", time.Now().UnixNano(), os.Getpid(), seq)
+	return t.write(name, spoolEntry{Delivery: d})
+}
+
+func (t *SpoolTransport) Dequeue(ctx context.Context) (*QueuedDelivery, error) {
+	for {
+		if err := t.requeueExpired(time.Now()); err != nil {
+			return nil, err
+		}
+		q, err := t.claim()
+		if err != nil || q != nil {
+			return q, err
+		}
+		timer := time.NewTimer(spoolPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// claim takes the oldest file in new, or returns nil when there is none.
+func (t *SpoolTransport) claim() (*QueuedDelivery, error) {
+	names, err := readDirNames(filepath.Join(t.dir, "new"))
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		cur := filepath.Join(t.dir, "cur", name)
+		if err := os.Rename(filepath.Join(t.dir, "new", name), cur); err != nil {
+			// claimed by another process
+			continue
+		}
+		// the modification time tells when the claim expires
+		now := time.Now()
+		os.Chtimes(cur, now, now)
+		b, err := ioutil.ReadFile(cur)
+		if err != nil {
+			return nil, xerrors.Errorf("error reading spool: %w", err)
+		}
+		var entry spoolEntry
+		if err := json.Unmarshal(b, &entry); err != nil {
+			os.Remove(cur)
+			return nil, xerrors.Errorf("error decoding spooled delivery %s: %v: %w", name, err, ErrMalformedDelivery)
+		}
+		if entry.Delivery == nil {
+			os.Remove(cur)
+			return nil, xerrors.Errorf("spooled delivery %s is empty: %w", name, ErrMalformedDelivery)
+		}
+		return &QueuedDelivery{Delivery: entry.Delivery, MessageID: name, Attempts: entry.Attempts + 1}, nil
+	}
+	return nil, nil
+}
+
+// requeueExpired moves the files claimed longer than the visibility
+// timeout ago back to new, counting the attempt.
+func (t *SpoolTransport) requeueExpired(now time.Time) error {
+	names, err := readDirNames(filepath.Join(t.dir, "cur"))
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		cur := filepath.Join(t.dir, "cur", name)
+		fi, err := os.Stat(cur)
+		if err != nil || now.Sub(fi.ModTime()) < t.timeout {
+			continue
+		}
+		// claim it for requeueing, so that only one process does
+		claimed := filepath.Join(t.dir, "tmp", name+".expired")
+		if err := os.Rename(cur, claimed); err != nil {
+			continue
+		}
+		b, err := ioutil.ReadFile(claimed)
+		if err != nil {
+			return xerrors.Errorf("error reading spool: %w", err)
+		}
+		var entry spoolEntry
+		if err := json.Unmarshal(b, &entry); err != nil {
+			os.Remove(claimed)
+			continue
+		}
+		entry.Attempts++
+		if err := t.write(name, entry); err != nil {
+			return err
+		}
+		os.Remove(claimed)
+	}
+	return nil
+}
+
+func readDirNames(dir string) ([]string, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return nil, xerrors.Errorf("error reading spool: %w", err)
+	}
+	defer f.Close()
+	names, err := f.Readdirnames(-1)
+	if err != nil {
+		return nil, xerrors.Errorf("error reading spool: %w", err)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (t *SpoolTransport) Ack(_ context.Context, q *QueuedDelivery) error {
+	cur := filepath.Join(t.dir, "cur", q.MessageID)
+	b, err := ioutil.ReadFile(cur)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	var entry spoolEntry
+	// requeueExpired counted another attempt when it handed it out again
+	if err == nil && json.Unmarshal(b, &entry) == nil && entry.Attempts+1 != q.Attempts {
+		return nil
+	}
+	if err := os.Remove(cur); err != nil && !os.IsNotExist(err) {
+		return xerrors.Errorf("error removing spooled delivery: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,313 @@
+package ghbot_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"github.com/nasa9084/ghbot"
+	"github.com/nasa9084/ghbot/ghbottest"
+	"golang.org/x/xerrors"
+)
+
+const testVisibilityTimeout = 300 * time.Millisecond
+
+// testTransport checks that deliveries are handed out in order, and again
+// when they are not acknowledged in time.
+func testTransport(t *testing.T, tr ghbot.Transport) {
+	t.Helper()
+	ctx := context.Background()
+	for _, id := range []string{"1", "2"} {
+		if err := tr.Enqueue(ctx, &ghbot.RecordedDelivery{ID: id, Event: "push", Body: `{}`}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dequeue := func(wantID string, wantAttempts int) *ghbot.QueuedDelivery {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		q, err := tr.Dequeue(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.Delivery.ID != wantID || q.Attempts != wantAttempts {
+			t.Errorf("dequeued delivery %s at attempt %d, want %s at %d", q.Delivery.ID, q.Attempts, wantID, wantAttempts)
+		}
+		return q
+	}
+
+	late := dequeue("1", 1)
+	q := dequeue("2", 1)
+	if err := tr.Ack(ctx, q); err != nil {
+		t.Fatal(err)
+	}
+	// the worker of the first delivery stalled, and acknowledges it after
+	// it was handed out again, which leaves the new claim alone
+	dequeue("1", 2)
+	if err := tr.Ack(ctx, late); err != nil {
+		t.Fatal(err)
+	}
+	q = dequeue("1", 3)
+	if err := tr.Ack(ctx, q); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 2*testVisibilityTimeout)
+	defer cancel()
+	if q, err := tr.Dequeue(ctx); !xerrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("dequeued %+v, %v from an empty queue", q, err)
+	}
+}
+
+type logRecorder struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *logRecorder) log(s string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lines = append(l.lines, s)
+}
+
+func (l *logRecorder) Print(v ...interface{})                 { l.log(fmt.Sprint(v...)) }
+func (l *logRecorder) Printf(format string, v ...interface{}) { l.log(fmt.Sprintf(format, v...)) }
+func (l *logRecorder) Println(v ...interface{})               { l.log(fmt.Sprintln(v...)) }
+
+func (l *logRecorder) contains(s string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, line := range l.lines {
+		if strings.Contains(line, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// testWorker enqueues a delivery through the handler of a receiver and
+// checks that a worker handles it. corrupt puts a message which cannot be
+// decoded into the queue first, which the worker must skip.
+func testWorker(t *testing.T, tr ghbot.Transport, corrupt func()) {
+	t.Helper()
+	receiver := ghbot.New(ghbot.Config{WebHookSecret: "secret"})
+	receiver.SetTransport(tr)
+	receiver.AddPushEventHook(func(context.Context, *github.PushEvent) error {
+		t.Error("receiver handled the delivery")
+		return nil
+	})
+
+	if corrupt != nil {
+		corrupt()
+	}
+	result := ghbottest.New(receiver, "secret").Send(ghbottest.PushTo("master").Delivery())
+	if result.StatusCode != http.StatusAccepted {
+		t.Fatalf("delivery was answered with %d", result.StatusCode)
+	}
+
+	var logger logRecorder
+	worker := ghbot.New(ghbot.Config{WebHookSecret: "secret"})
+	worker.SetLogger(&logger)
+	handled := make(chan string, 1)
+	worker.AddPushEventHook(func(_ context.Context, e *github.PushEvent) error {
+		handled <- e.GetRef()
+		return nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- worker.RunWorker(ctx, tr) }()
+
+	select {
+	case ref := <-handled:
+		if ref != "refs/heads/master" {
+			t.Errorf("handled push to %s", ref)
+		}
+	case err := <-done:
+		t.Fatalf("worker stopped: %+v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("delivery was not handled")
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("RunWorker returned %+v, want context.Canceled", err)
+	}
+	if corrupt != nil && !logger.contains("dropping delivery") {
+		t.Errorf("corrupt message was not logged: %q", logger.lines)
+	}
+}
+
+func TestMemoryTransport(t *testing.T) {
+	testTransport(t, ghbot.NewMemoryTransport(testVisibilityTimeout))
+	testWorker(t, ghbot.NewMemoryTransport(testVisibilityTimeout), nil)
+}
+
+func TestSpoolTransport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ghbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tr, err := ghbot.NewSpoolTransport(filepath.Join(dir, "a"), testVisibilityTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testTransport(t, tr)
+
+	spool := filepath.Join(dir, "b")
+	if tr, err = ghbot.NewSpoolTransport(spool, testVisibilityTimeout); err != nil {
+		t.Fatal(err)
+	}
+	testWorker(t, tr, func() {
+		if err := ioutil.WriteFile(filepath.Join(spool, "new", "0-corrupt.json"), []byte("not json"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	})
+	for _, sub := range []string{"new", "cur", "tmp"} {
+		names, err := ioutil.ReadDir(filepath.Join(spool, sub))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(names) != 0 {
+			t.Errorf("%d files left in %s", len(names), sub)
+		}
+	}
+}
+
+// redisCommand sends a command to a Redis server and returns the first
+// line of the reply.
+func redisCommand(addr string, args ...string) (string, error) {
+	c, err := net.Dial("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+	defer c.Close()
+	fmt.Fprintf(c, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(c, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	return bufio.NewReader(c).ReadString('\n')
+}
+
+func TestRedisTransport(t *testing.T) {
+	srv := ghbottest.NewRedisServer()
+	defer srv.Close()
+
+	tr := ghbot.NewRedisTransport(ghbot.RedisOptions{Addr: srv.Addr, Stream: "a", VisibilityTimeout: testVisibilityTimeout})
+	defer tr.Close()
+	testTransport(t, tr)
+	if n := srv.Len("a"); n != 0 {
+		t.Errorf("%d entries left in the stream", n)
+	}
+	if n := srv.Pending("a", "ghbot"); n != 0 {
+		t.Errorf("%d entries left pending", n)
+	}
+
+	tr = ghbot.NewRedisTransport(ghbot.RedisOptions{Addr: srv.Addr, Stream: "b", VisibilityTimeout: testVisibilityTimeout})
+	defer tr.Close()
+	testWorker(t, tr, func() {
+		if reply, err := redisCommand(srv.Addr, "XADD", "b", "*", "delivery", "not json"); err != nil || strings.HasPrefix(reply, "-") {
+			t.Fatalf("error adding corrupt entry: %q, %v", reply, err)
+		}
+	})
+	if n := srv.Len("b"); n != 0 {
+		t.Errorf("%d entries left in the stream", n)
+	}
+}
+
+func TestRunWorkerMaxAttempts(t *testing.T) {
+	tr := ghbot.NewMemoryTransport(testVisibilityTimeout)
+	ctx := context.Background()
+	if err := tr.Enqueue(ctx, &ghbot.RecordedDelivery{ID: "1", Event: "push", Body: `{}`}); err != nil {
+		t.Fatal(err)
+	}
+	// two workers crashed handling it
+	for i := 0; i < 2; i++ {
+		if _, err := tr.Dequeue(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var logger logRecorder
+	bot := ghbot.New(ghbot.Config{})
+	bot.SetLogger(&logger)
+	bot.SetMaxDeliveryAttempts(2)
+	bot.AddRawEventHook("push", func(context.Context, json.RawMessage) error {
+		t.Error("delivery was handled a third time")
+		return nil
+	})
+	ctx, cancel := context.WithTimeout(ctx, 3*testVisibilityTimeout)
+	defer cancel()
+	if err := bot.RunWorker(ctx, tr); err != context.DeadlineExceeded {
+		t.Errorf("RunWorker returned %+v", err)
+	}
+	if !logger.contains("dropping delivery 1 after 2 attempts") {
+		t.Errorf("logged %q", logger.lines)
+	}
+
+	// it was acknowledged
+	ctx, cancel = context.WithTimeout(context.Background(), 2*testVisibilityTimeout)
+	defer cancel()
+	if q, err := tr.Dequeue(ctx); err == nil {
+		t.Errorf("dequeued %+v again", q)
+	}
+}
+
+func TestRunWorkerCanceled(t *testing.T) {
+	tr := ghbot.NewMemoryTransport(testVisibilityTimeout)
+	if err := tr.Enqueue(context.Background(), &ghbot.RecordedDelivery{ID: "1", Event: "push", Body: `{}`}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bot := ghbot.New(ghbot.Config{})
+	bot.AddRawEventHook("push", func(context.Context, json.RawMessage) error {
+		// the worker is stopped while handling the delivery
+		cancel()
+		return nil
+	})
+	if err := bot.RunWorker(ctx, tr); err != context.Canceled {
+		t.Errorf("RunWorker returned %+v", err)
+	}
+
+	// it was not acknowledged, so that another worker handles it
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	q, err := tr.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Delivery.ID != "1" || q.Attempts != 2 {
+		t.Errorf("dequeued delivery %s at attempt %d", q.Delivery.ID, q.Attempts)
+	}
+}
+
+func TestRunWorkerConnectionError(t *testing.T) {
+	srv := ghbottest.NewRedisServer()
+	addr := srv.Addr
+	srv.Close()
+
+	bot := ghbot.New(ghbot.Config{})
+	tr := ghbot.NewRedisTransport(ghbot.RedisOptions{Addr: addr})
+	defer tr.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := bot.RunWorker(ctx, tr)
+	if err == nil || xerrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RunWorker returned %v, want the connection error", err)
+	}
+}
@@ -0,0 +1,242 @@
+package ghbot
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// requests are paced once less than this fraction of the rate limit
+	// is left.
+	rateLimitReserve = 0.1
+	// secondary rate limits are retried this many times before the
+	// response is handed to the caller.
+	maxRateLimitRetries = 3
+)
+
+// RateLimitBudget is the rate limit of a credential as last reported by
+// GitHub.
+type RateLimitBudget struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+	// Delayed counts requests which were held back to spread the
+	// remaining budget until Reset.
+	Delayed int64
+	// SecondaryLimited counts responses which hit a secondary rate limit.
+	SecondaryLimited int64
+}
+
+// Metrics is a snapshot of the state of the clients the bot created.
+type Metrics struct {
+	// RateLimits is keyed by credential: "app", "installation:<id>" or
+	// "token:<hash prefix>". Search requests have their own budget, keyed
+	// with a ":search" suffix.
+	RateLimits map[string]RateLimitBudget
+	Cache      CacheStats
+}
+
+// Metrics returns the current state of the clients the bot created.
+func (bot *Bot) Metrics() Metrics {
+	return Metrics{
+		RateLimits: bot.rateLimits.budgets(),
+		Cache:      bot.cache.snapshot(),
+	}
+}
+
+type urgentContextKey struct{}
+
+// WithUrgent marks requests made with ctx as urgent. Urgent requests are
+// sent right away even when the rate limit is running low.
+func WithUrgent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, urgentContextKey{}, true)
+}
+
+func isUrgent(ctx context.Context) bool {
+	urgent, _ := ctx.Value(urgentContextKey{}).(bool)
+	return urgent
+}
+
+// rateLimitTransport tracks the rate limit of every credential and
+// delays requests so that the budget lasts until it is reset. Once the
+// budget is exhausted, requests wait for the reset here; responses keep
+// their headers, so the go-github client which received the last of the
+// budget fails its own requests with a RateLimitError until the reset,
+// as it does without this transport. Requests hitting a secondary rate
+// limit are retried after Retry-After.
+type rateLimitTransport struct {
+	base  http.RoundTripper
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu    sync.Mutex
+	state map[string]*RateLimitBudget
+	// next is the time the last paced request of a credential was given,
+	// so that concurrent requests are spread instead of sent together.
+	next map[string]time.Time
+}
+
+func newRateLimitTransport(base http.RoundTripper) *rateLimitTransport {
+	return &rateLimitTransport{
+		base:  base,
+		now:   time.Now,
+		sleep: sleepContext,
+		state: map[string]*RateLimitBudget{},
+		next:  map[string]time.Time{},
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *rateLimitTransport) budgets() map[string]RateLimitBudget {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	budgets := make(map[string]RateLimitBudget, len(t.state))
+	for key, b := range t.state {
+		budgets[key] = *b
+	}
+	return budgets
+}
+
+func rateLimitKey(req *http.Request) string {
+	key := requestCredential(req)
+	if key == "" {
+		key = "anonymous"
+	}
+	if strings.HasPrefix(strings.TrimPrefix(req.URL.Path, "/api/v3"), "/search/") {
+		key += ":search"
+	}
+	return key
+}
+
+// delay returns how long a request should wait for the budget of key to
+// last until it is reset, and reserves the slot it waits for.
+func (t *rateLimitTransport) delay(key string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	b, ok := t.state[key]
+	if !ok || b.Limit == 0 {
+		return 0
+	}
+	now := t.now()
+	untilReset := b.Reset.Sub(now)
+	if untilReset <= 0 || float64(b.Remaining) >= float64(b.Limit)*rateLimitReserve {
+		return 0
+	}
+	b.Delayed++
+	if b.Remaining <= 0 {
+		t.next[key] = b.Reset
+		return untilReset
+	}
+	slot := t.next[key]
+	if slot.Before(now) {
+		slot = now
+	}
+	slot = slot.Add(untilReset / time.Duration(b.Remaining+1))
+	t.next[key] = slot
+	return slot.Sub(now)
+}
+
+func (t *rateLimitTransport) update(key string, resp *http.Response) {
+	limit, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, _ := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	reset, _ := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	b, ok := t.state[key]
+	if !ok {
+		b = &RateLimitBudget{}
+		t.state[key] = b
+	}
+	b.Limit, b.Remaining, b.Reset = limit, remaining, time.Unix(reset, 0)
+}
+
+func (t *rateLimitTransport) secondaryLimited(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	b, ok := t.state[key]
+	if !ok {
+		b = &RateLimitBudget{}
+		t.state[key] = b
+	}
+	b.SecondaryLimited++
+}
+
+// retryAfter returns how long to wait before retrying resp, or false when
+// it did not hit a secondary rate limit.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	secs, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || secs < 0 {
+		return 0, false
+	}
+	return time.Duration(secs) * time.Second, true
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	ctx := req.Context()
+	key := rateLimitKey(req)
+
+	if !isUrgent(ctx) {
+		if d := t.delay(key); d > 0 {
+			if err := t.sleep(ctx, d); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for i := 0; ; i++ {
+		resp, err := base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		t.update(key, resp)
+		d, limited := retryAfter(resp)
+		if !limited {
+			return resp, nil
+		}
+		t.secondaryLimited(key)
+		if i == maxRateLimitRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+		resp.Body.Close()
+		if err := t.sleep(ctx, d); err != nil {
+			return nil, err
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r := *req
+			r.Body = body
+			req = &r
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package ghbot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+)
+
+// fakeRateLimitServer answers with the rate limit headers and statuses it
+// is given, and records the bodies of the requests.
+type fakeRateLimitServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	remaining int
+	reset     time.Time
+	statuses  []int
+	bodies    []string
+}
+
+func newFakeRateLimitServer(reset time.Time) *fakeRateLimitServer {
+	s := &fakeRateLimitServer{remaining: 100, reset: reset}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.bodies = append(s.bodies, string(b))
+		status := http.StatusOK
+		if len(s.statuses) > 0 {
+			status, s.statuses = s.statuses[0], s.statuses[1:]
+		}
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(s.remaining))
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(s.reset.Unix(), 10))
+		if status != http.StatusOK {
+			w.Header().Set("Retry-After", "3")
+		}
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{}`)
+	}))
+	return s
+}
+
+func (s *fakeRateLimitServer) set(remaining int, statuses ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remaining, s.statuses = remaining, statuses
+}
+
+// fakeSleep records how long the transport waited instead of waiting.
+type fakeSleep struct {
+	mu     sync.Mutex
+	sleeps []time.Duration
+}
+
+func (s *fakeSleep) sleep(_ context.Context, d time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sleeps = append(s.sleeps, d)
+	return nil
+}
+
+func (s *fakeSleep) take() []time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sleeps := s.sleeps
+	s.sleeps = nil
+	return sleeps
+}
+
+func newTestRateLimitTransport(now time.Time) (*rateLimitTransport, *fakeSleep) {
+	sleep := &fakeSleep{}
+	t := newRateLimitTransport(http.DefaultTransport)
+	t.now = func() time.Time { return now }
+	t.sleep = sleep.sleep
+	return t, sleep
+}
+
+func sendRateLimited(t *testing.T, rt http.RoundTripper, req *http.Request) *http.Response {
+	t.Helper()
+	resp, err := roundTripWithAuthorization(rt, req, "token secret", "token:test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp
+}
+
+func TestRateLimitPacing(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	srv := newFakeRateLimitServer(now.Add(100 * time.Second))
+	defer srv.Close()
+	rt, sleep := newTestRateLimitTransport(now)
+
+	get := func(ctx context.Context) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/repos/octocat/hello-world", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req.WithContext(ctx)
+	}
+
+	// nothing is known about the budget yet
+	sendRateLimited(t, rt, get(context.Background()))
+	srv.set(10)
+	sendRateLimited(t, rt, get(context.Background()))
+	if sleeps := sleep.take(); len(sleeps) != 0 {
+		t.Errorf("requests above the reserve were delayed by %v", sleeps)
+	}
+
+	// below the reserve the rest of the budget is spread until reset
+	srv.set(9)
+	sendRateLimited(t, rt, get(context.Background()))
+	sendRateLimited(t, rt, get(context.Background()))
+	if sleeps := sleep.take(); len(sleeps) != 1 || sleeps[0] != 10*time.Second {
+		t.Errorf("request below the reserve was delayed by %v, want [10s]", sleeps)
+	}
+
+	// an exhausted budget waits for the reset; the clock stands still, so
+	// the paced request takes the slot after the previous one
+	srv.set(0)
+	sendRateLimited(t, rt, get(context.Background()))
+	resp := sendRateLimited(t, rt, get(context.Background()))
+	if sleeps := sleep.take(); len(sleeps) != 2 || sleeps[0] != 20*time.Second || sleeps[1] != 100*time.Second {
+		t.Errorf("requests were delayed by %v, want [20s 1m40s]", sleeps)
+	}
+	if got, want := resp.Header.Get("X-RateLimit-Reset"), strconv.FormatInt(now.Add(100*time.Second).Unix(), 10); got != want {
+		t.Errorf("X-RateLimit-Reset = %q, want the header of the server %q", got, want)
+	}
+
+	// urgent requests are never delayed
+	sendRateLimited(t, rt, get(WithUrgent(context.Background())))
+	if sleeps := sleep.take(); len(sleeps) != 0 {
+		t.Errorf("urgent request was delayed by %v", sleeps)
+	}
+
+	b := rt.budgets()["token:test"]
+	if b.Limit != 100 || b.Remaining != 0 || !b.Reset.Equal(now.Add(100*time.Second)) || b.Delayed != 3 {
+		t.Errorf("budget = %+v", b)
+	}
+}
+
+func TestRateLimitPacingConcurrency(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	rt, _ := newTestRateLimitTransport(now)
+	rt.state["token:test"] = &RateLimitBudget{Limit: 100, Remaining: 4, Reset: now.Add(50 * time.Second)}
+
+	// concurrent requests get one slot each instead of all waiting for
+	// the first one
+	var (
+		mu     sync.Mutex
+		delays []time.Duration
+		wg     sync.WaitGroup
+	)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d := rt.delay("token:test")
+			mu.Lock()
+			delays = append(delays, d)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	sort.Slice(delays, func(i, j int) bool { return delays[i] < delays[j] })
+	if len(delays) != 3 || delays[0] != 10*time.Second || delays[1] != 20*time.Second || delays[2] != 30*time.Second {
+		t.Errorf("requests were delayed by %v, want [10s 20s 30s]", delays)
+	}
+
+	// slots which have passed are not waited for
+	rt.now = func() time.Time { return now.Add(40 * time.Second) }
+	if d := rt.delay("token:test"); d != 2*time.Second {
+		t.Errorf("request after the slots was delayed by %v, want 2s", d)
+	}
+}
+
+func TestRateLimitExhausted(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+	srv := newFakeRateLimitServer(reset)
+	defer srv.Close()
+	srv.set(0)
+
+	bot := New(Config{GitHubToken: "secret", EnterpriseBaseURL: srv.URL + "/api/v3/"})
+	if err := bot.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	sleep := &fakeSleep{}
+	bot.rateLimits.sleep = sleep.sleep
+	ctx := context.Background()
+	client, err := bot.clientFactory(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Repositories.Get(ctx, "octocat", "hello-world"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the client which used up the budget knows the reset and fails
+	// without a request
+	if _, _, err := client.Repositories.Get(ctx, "octocat", "other"); err == nil {
+		t.Error("request with an exhausted budget succeeded")
+	} else if _, ok := err.(*github.RateLimitError); !ok {
+		t.Errorf("error = %v, want a RateLimitError", err)
+	}
+	if sleeps := sleep.take(); len(sleeps) != 0 {
+		t.Errorf("the transport delayed the request by %v", sleeps)
+	}
+	srv.mu.Lock()
+	requests := len(srv.bodies)
+	srv.mu.Unlock()
+	if requests != 1 {
+		t.Errorf("%d requests, want 1", requests)
+	}
+
+	// other clients of the credential are held back until the reset
+	other := bot.newGitHubClient(func(base http.RoundTripper) http.RoundTripper {
+		return &tokenTransport{token: "secret", base: base}
+	})
+	srv.set(100)
+	if _, _, err := other.Repositories.Get(ctx, "octocat", "other"); err != nil {
+		t.Fatal(err)
+	}
+	if sleeps := sleep.take(); len(sleeps) != 1 || sleeps[0] <= 59*time.Minute {
+		t.Errorf("request was delayed by %v, want until the reset", sleeps)
+	}
+}
+
+func TestRateLimitRetryAfter(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	srv := newFakeRateLimitServer(now.Add(time.Hour))
+	defer srv.Close()
+	rt, sleep := newTestRateLimitTransport(now)
+
+	post := func(body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+"/repos/octocat/hello-world/issues", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+
+	// secondary rate limits are retried with the body replayed
+	srv.set(50, http.StatusForbidden, http.StatusTooManyRequests)
+	if resp := sendRateLimited(t, rt, post(`{"title":"hello"}`)); resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d after retrying", resp.StatusCode)
+	}
+	if sleeps := sleep.take(); len(sleeps) != 2 || sleeps[0] != 3*time.Second || sleeps[1] != 3*time.Second {
+		t.Errorf("retries waited %v, want [3s 3s]", sleeps)
+	}
+	srv.mu.Lock()
+	bodies := srv.bodies
+	srv.bodies = nil
+	srv.mu.Unlock()
+	if len(bodies) != 3 {
+		t.Fatalf("%d attempts, want 3", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"title":"hello"}` {
+			t.Errorf("attempt %d sent %q", i, body)
+		}
+	}
+
+	// retries are bounded
+	srv.set(50, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests)
+	if resp := sendRateLimited(t, rt, post(`{}`)); resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want 429 after giving up", resp.StatusCode)
+	}
+	if sleeps := sleep.take(); len(sleeps) != maxRateLimitRetries {
+		t.Errorf("%d retries, want %d", len(sleeps), maxRateLimitRetries)
+	}
+
+	// bodies which cannot be replayed are not retried
+	srv.set(50, http.StatusForbidden)
+	req := post(`{}`)
+	req.GetBody = nil
+	if resp := sendRateLimited(t, rt, req); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("status = %d, want 403 without retrying", resp.StatusCode)
+	}
+	if sleeps := sleep.take(); len(sleeps) != 0 {
+		t.Errorf("request without GetBody was retried after %v", sleeps)
+	}
+
+	if n := rt.budgets()["token:test"].SecondaryLimited; n != 7 {
+		t.Errorf("SecondaryLimited = %d, want 7", n)
+	}
+}
+
+func TestMetricsRateLimits(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+	srv := newFakeRateLimitServer(reset)
+	defer srv.Close()
+	srv.set(42)
+
+	bot := New(Config{GitHubToken: "secret", EnterpriseBaseURL: srv.URL + "/api/v3/"})
+	if err := bot.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	client, err := bot.clientFactory(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, _, err := client.Repositories.Get(ctx, "octocat", "hello-world"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Search.Repositories(ctx, "ghbot", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256([]byte("secret"))
+	key := "token:" + hex.EncodeToString(sum[:4])
+	budgets := bot.Metrics().RateLimits
+	if len(budgets) != 2 {
+		t.Errorf("budgets of %d credentials, want 2: %v", len(budgets), budgets)
+	}
+	for _, key := range []string{key, key + ":search"} {
+		b, ok := budgets[key]
+		if !ok {
+			t.Errorf("no budget of %s", key)
+			continue
+		}
+		if b.Limit != 100 || b.Remaining != 42 || !b.Reset.Equal(reset) {
+			t.Errorf("budget of %s = %+v", key, b)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package ghbot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// RecordedDelivery is a webhook delivery as written by a Recorder, one
+// JSON object per line.
+type RecordedDelivery struct {
+	ID    string `json:"id"`
+	Event string `json:"event"`
+	// Signature and Signature256 are X-Hub-Signature and
+	// X-Hub-Signature-256 as received.
+	Signature    string      `json:"signature,omitempty"`
+	Signature256 string      `json:"signature_256,omitempty"`
+	Header       http.Header `json:"header"`
+	// Body is the request body as received, which is not necessarily
+	// JSON for webhooks with the form content type.
+	Body       string    `json:"body"`
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+func newRecordedDelivery(r *http.Request, body []byte, receivedAt time.Time) *RecordedDelivery {
+	return &RecordedDelivery{
+		ID:           r.Header.Get("X-GitHub-Delivery"),
+		Event:        r.Header.Get("X-GitHub-Event"),
+		Signature:    r.Header.Get("X-Hub-Signature"),
+		Signature256: r.Header.Get("X-Hub-Signature-256"),
+		Header:       r.Header,
+		Body:         string(body),
+		ReceivedAt:   receivedAt,
+	}
+}
+
+// Recorder appends deliveries to a JSONL file per day in a directory.
+type Recorder struct {
+	dir string
+	now func() time.Time
+
+	mu   sync.Mutex
+	day  string
+	file *os.File
+}
+
+// NewRecorder creates dir if it does not exist.
+func NewRecorder(dir string) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, xerrors.Errorf("error creating recording directory: %w", err)
+	}
+	return &Recorder{dir: dir, now: time.Now}, nil
+}
+
+// Record appends d to the file of the day it was received, named like
+// "deliveries-2019-05-15.jsonl".
+func (rec *Recorder) Record(d *RecordedDelivery) error {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return xerrors.Errorf("error encoding delivery: %w", err)
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	day := d.ReceivedAt.UTC().Format("2006-01-02")
+	if rec.file == nil || rec.day != day {
+		if rec.file != nil {
+			rec.file.Close()
+		}
+		f, err := os.OpenFile(filepath.Join(rec.dir, "deliveries-"+day+".jsonl"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			rec.file = nil
+			return xerrors.Errorf("error opening recording: %w", err)
+		}
+		rec.file, rec.day = f, day
+	}
+	if _, err := rec.file.Write(append(b, '\n')); err != nil {
+		return xerrors.Errorf("error writing recording: %w", err)
+	}
+	return nil
+}
+
+func (rec *Recorder) Close() error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if rec.file == nil {
+		return nil
+	}
+	err := rec.file.Close()
+	rec.file = nil
+	return err
+}
+
+// SetRecorder makes the bot record every delivery it receives with rec,
+// including ones with an invalid signature. nil stops recording.
+func (bot *Bot) SetRecorder(rec *Recorder) {
+	bot.mu.Lock()
+	defer bot.mu.Unlock()
+
+	bot.recorder = rec
+}
+
+// recordDelivery records the delivery r if a recorder is set. The body
+// is put back so that it can still be validated.
+func (bot *Bot) recordDelivery(r *http.Request, receivedAt time.Time) {
+	bot.mu.Lock()
+	rec := bot.recorder
+	bot.mu.Unlock()
+	if rec == nil {
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	if err := rec.Record(newRecordedDelivery(r, body, receivedAt)); err != nil {
+		bot.logger.Printf("error recording delivery: %+v", err)
+	}
+}
+
+// ReadRecording reads the deliveries in a file written by a Recorder.
+func ReadRecording(path string) ([]*RecordedDelivery, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, xerrors.Errorf("error opening recording: %w", err)
+	}
+	defer f.Close()
+
+	var ds []*RecordedDelivery
+	sc := bufio.NewScanner(f)
+	sc.Buffer(nil, 64<<20)
+	for line := 1; sc.Scan(); line++ {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		var d RecordedDelivery
+		if err := json.Unmarshal(sc.Bytes(), &d); err != nil {
+			return nil, xerrors.Errorf("%s:%d: error parsing delivery: %w", path, line, err)
+		}
+		ds = append(ds, &d)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, xerrors.Errorf("error reading recording: %w", err)
+	}
+	return ds, nil
+}
+
+// ReplayOptions selects the deliveries to replay and how.
+type ReplayOptions struct {
+	// Events are the event types to replay. All are replayed when empty.
+	Events []string
+	// Repos are the repositories, written as "owner/name", whose events
+	// are replayed. All are replayed when empty.
+	Repos []string
+	// Secret re-signs deliveries sent to a URL by ReplayTo. They are sent
+	// with their recorded signatures when empty. Replay checks no
+	// signatures, so it ignores Secret.
+	Secret string
+}
+
+func (opts ReplayOptions) match(d *RecordedDelivery) bool {
+	if len(opts.Events) > 0 && !containsFold(opts.Events, d.Event) {
+		return false
+	}
+	if len(opts.Repos) > 0 && !containsFold(opts.Repos, d.repository()) {
+		return false
+	}
+	return true
+}
+
+func containsFold(ss []string, s string) bool {
+	for _, x := range ss {
+		if strings.EqualFold(x, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// payload returns the JSON payload of the delivery, taking it out of the
+// form for webhooks with the form content type.
+func (d *RecordedDelivery) payload() ([]byte, error) {
+	r, err := d.Request("http://localhost/", "")
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if err := r.ParseForm(); err != nil {
+			return nil, xerrors.Errorf("error parsing form of delivery %s: %w", d.ID, err)
+		}
+		return []byte(r.PostForm.Get("payload")), nil
+	}
+	return []byte(d.Body), nil
+}
+
+func (d *RecordedDelivery) repository() string {
+	payload, err := d.payload()
+	if err != nil {
+		return ""
+	}
+	var p struct {
+		Repository struct {
+			FullName string `json:"full_name"`
+		} `json:"repository"`
+	}
+	json.Unmarshal(payload, &p)
+	return p.Repository.FullName
+}
+
+// Request returns the delivery as a request to url with the recorded
+// headers, re-signed with secret unless it is empty.
+func (d *RecordedDelivery) Request(url, secret string) (*http.Request, error) {
+	r, err := http.NewRequest(http.MethodPost, url, strings.NewReader(d.Body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range d.Header {
+		r.Header[k] = v
+	}
+	r.Header.Del("Content-Length")
+	r.Header.Set("X-GitHub-Event", d.Event)
+	r.Header.Set("X-GitHub-Delivery", d.ID)
+	if secret != "" {
+		signature, signature256 := SignPayload(secret, []byte(d.Body))
+		r.Header.Set("X-Hub-Signature", signature)
+		r.Header.Set("X-Hub-Signature-256", signature256)
+	}
+	return r, nil
+}
+
+// Replay handles the deliveries matching opts in order, as if they had
+// just been received, but without checking signatures: they are neither
+// verified nor re-signed, so use ReplayTo to exercise the webhook secret
+// of a bot. Hooks can tell the original time of a delivery from
+// DeliveryFromContext. Replay stops at the first delivery which fails.
+func (bot *Bot) Replay(ctx context.Context, ds []*RecordedDelivery, opts ReplayOptions) error {
+	for _, d := range ds {
+		if !opts.match(d) {
+			continue
+		}
+		if err := bot.handleRecordedDelivery(ctx, d); err != nil {
+			return xerrors.Errorf("error replaying delivery %s: %w", d.ID, err)
+		}
+	}
+	return nil
+}
+
+// handleRecordedDelivery handles d without checking its signature, with
+// the metadata of the original delivery.
+func (bot *Bot) handleRecordedDelivery(ctx context.Context, d *RecordedDelivery) error {
+	r, err := d.Request("http://localhost/", "")
+	if err != nil {
+		return err
+	}
+	payload, err := d.payload()
+	if err != nil {
+		return err
+	}
+	ctx = withDelivery(ctx, newDeliveryMetadata(r, d.ReceivedAt))
+	return bot.handleWebHookPayload(ctx, d.Event, payload)
+}
+
+// ReplayTo sends the deliveries matching opts in order to the webhook
+// endpoint of a running bot at url. It stops at the first delivery which
+// is not answered with a 2xx status.
+func ReplayTo(ctx context.Context, client *http.Client, url string, ds []*RecordedDelivery, opts ReplayOptions) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	for _, d := range ds {
+		if !opts.match(d) {
+			continue
+		}
+		r, err := d.Request(url, opts.Secret)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(r.WithContext(ctx))
+		if err != nil {
+			return xerrors.Errorf("error replaying delivery %s: %w", d.ID, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			return xerrors.Errorf("error replaying delivery %s: %s", d.ID, resp.Status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package ghbot
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+func recordedDelivery(id, event, repo string, receivedAt time.Time) *RecordedDelivery {
+	body := `{"repository":{"full_name":"` + repo + `"}}`
+	signature, signature256 := SignPayload("recorded", []byte(body))
+	return &RecordedDelivery{
+		ID:           id,
+		Event:        event,
+		Signature:    signature,
+		Signature256: signature256,
+		Header: http.Header{
+			"Content-Type":        {"application/json"},
+			"X-Hub-Signature":     {signature},
+			"X-Hub-Signature-256": {signature256},
+		},
+		Body:       body,
+		ReceivedAt: receivedAt,
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ghbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rec, err := NewRecorder(filepath.Join(dir, "recordings"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := time.Date(2019, 5, 15, 23, 0, 0, 0, time.UTC)
+	for _, d := range []*RecordedDelivery{
+		recordedDelivery("1", "push", "octocat/hello-world", day),
+		recordedDelivery("2", "issues", "octocat/hello-world", day.Add(30*time.Minute)),
+		// the file is rotated at midnight UTC
+		recordedDelivery("3", "push", "acme/widgets", day.Add(time.Hour)),
+	} {
+		if err := rec.Record(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// records are appended to the file of the day after a restart
+	rec, err = NewRecorder(filepath.Join(dir, "recordings"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Record(recordedDelivery("4", "push", "acme/widgets", day.Add(2*time.Hour))); err != nil {
+		t.Fatal(err)
+	}
+	rec.Close()
+
+	for file, want := range map[string][]string{
+		"deliveries-2019-05-15.jsonl": {"1", "2"},
+		"deliveries-2019-05-16.jsonl": {"3", "4"},
+	} {
+		ds, err := ReadRecording(filepath.Join(dir, "recordings", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, d := range ds {
+			ids = append(ids, d.ID)
+		}
+		if strings.Join(ids, ",") != strings.Join(want, ",") {
+			t.Errorf("%s holds %v, want %v", file, ids, want)
+		}
+	}
+
+	ds, err := ReadRecording(filepath.Join(dir, "recordings", "deliveries-2019-05-15.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := recordedDelivery("1", "push", "octocat/hello-world", day)
+	if d := ds[0]; d.Event != want.Event || d.Body != want.Body || d.Signature256 != want.Signature256 ||
+		!d.ReceivedAt.Equal(want.ReceivedAt) || d.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("read %+v, want %+v", d, want)
+	}
+
+	malformed := filepath.Join(dir, "malformed.jsonl")
+	if err := ioutil.WriteFile(malformed, []byte("{\"id\":\"1\"}\n\n{\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadRecording(malformed); err == nil || !strings.Contains(err.Error(), "malformed.jsonl:3:") {
+		t.Errorf("error = %v, want one of line 3", err)
+	}
+}
+
+func TestRecordDeliveries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ghbot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	rec, err := NewRecorder(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rec.Close()
+
+	bot := New(Config{WebHookSecret: "secret"})
+	bot.SetRecorder(rec)
+	ran := 0
+	bot.AddRawEventHook("push", func(context.Context, json.RawMessage) error {
+		ran++
+		return nil
+	})
+	// deliveries are recorded whether their signature is valid or not,
+	// and still handled
+	if status := deliver(bot, "secret", "push", `{"ref":"refs/heads/master"}`); status != http.StatusOK {
+		t.Errorf("status = %d", status)
+	}
+	if status := deliver(bot, "other", "push", `{}`); status != http.StatusBadRequest {
+		t.Errorf("status = %d with a wrong signature", status)
+	}
+	bot.SetRecorder(nil)
+	deliver(bot, "secret", "push", `{}`)
+	if ran != 2 {
+		t.Errorf("hook ran %d times, want 2", ran)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "deliveries-*.jsonl"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("recordings = %v, %v", files, err)
+	}
+	ds, err := ReadRecording(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 2 || ds[0].Body != `{"ref":"refs/heads/master"}` || ds[0].Event != "push" || ds[0].ID == "" || ds[0].Signature256 == "" {
+		t.Fatalf("recorded %+v", ds)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	receivedAt := time.Date(2019, 5, 15, 0, 0, 0, 0, time.UTC)
+	form := recordedDelivery("form", "push", "acme/widgets", receivedAt)
+	form.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	form.Body = url.Values{"payload": {form.Body}}.Encode()
+	ds := []*RecordedDelivery{
+		recordedDelivery("1", "push", "octocat/hello-world", receivedAt),
+		recordedDelivery("2", "issues", "Acme/Widgets", receivedAt),
+		recordedDelivery("3", "push", "acme/widgets", receivedAt),
+		form,
+	}
+
+	tests := []struct {
+		opts ReplayOptions
+		want string
+	}{
+		{ReplayOptions{}, "1 push octocat/hello-world, 2 issues Acme/Widgets, 3 push acme/widgets, form push acme/widgets"},
+		{ReplayOptions{Events: []string{"Push"}}, "1 push octocat/hello-world, 3 push acme/widgets, form push acme/widgets"},
+		{ReplayOptions{Repos: []string{"acme/widgets"}}, "2 issues Acme/Widgets, 3 push acme/widgets, form push acme/widgets"},
+		{ReplayOptions{Events: []string{"issues"}, Repos: []string{"octocat/hello-world"}}, ""},
+		// the recorded signatures are not checked
+		{ReplayOptions{Secret: "ignored"}, "1 push octocat/hello-world, 2 issues Acme/Widgets, 3 push acme/widgets, form push acme/widgets"},
+	}
+	for _, tt := range tests {
+		bot := New(Config{WebHookSecret: "secret"})
+		var replayed []string
+		bot.AddAnyEventHook(func(ctx context.Context, event string, _ interface{}) error {
+			md := DeliveryFromContext(ctx)
+			if !md.ReceivedAt.Equal(receivedAt) {
+				t.Errorf("delivery %s was received at %s", md.ID, md.ReceivedAt)
+			}
+			repo, _ := ctx.Value(eventRepositoryContextKey{}).(string)
+			replayed = append(replayed, md.ID+" "+event+" "+repo)
+			return nil
+		})
+		if err := bot.Replay(context.Background(), ds, tt.opts); err != nil {
+			t.Fatalf("%+v: %+v", tt.opts, err)
+		}
+		if got := strings.Join(replayed, ", "); got != tt.want {
+			t.Errorf("%+v: replayed %s, want %s", tt.opts, got, tt.want)
+		}
+	}
+
+	// replay stops at the first failure
+	errHook := xerrors.New("hook failed")
+	bot := New(Config{})
+	var replayed []string
+	bot.AddAnyEventHook(func(ctx context.Context, _ string, _ interface{}) error {
+		id := DeliveryFromContext(ctx).ID
+		replayed = append(replayed, id)
+		if id == "2" {
+			return errHook
+		}
+		return nil
+	})
+	if err := bot.Replay(context.Background(), ds, ReplayOptions{}); !xerrors.Is(err, errHook) || !strings.Contains(err.Error(), "delivery 2") {
+		t.Errorf("error = %v", err)
+	}
+	if strings.Join(replayed, ",") != "1,2" {
+		t.Errorf("replayed %v", replayed)
+	}
+}
+
+func TestReplayTo(t *testing.T) {
+	bot := New(Config{WebHookSecret: "secret"})
+	var received []string
+	bot.AddAnyEventHook(func(ctx context.Context, event string, _ interface{}) error {
+		received = append(received, DeliveryFromContext(ctx).ID)
+		return nil
+	})
+	srv := httptest.NewServer(bot.Handler())
+	defer srv.Close()
+
+	ds := []*RecordedDelivery{
+		recordedDelivery("1", "push", "octocat/hello-world", time.Now()),
+		recordedDelivery("2", "issues", "octocat/hello-world", time.Now()),
+		recordedDelivery("3", "push", "acme/widgets", time.Now()),
+	}
+	ctx := context.Background()
+
+	// deliveries are re-signed with the secret of the receiving bot
+	if err := ReplayTo(ctx, nil, srv.URL, ds, ReplayOptions{Events: []string{"push"}, Secret: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(received, ",") != "1,3" {
+		t.Errorf("received %v", received)
+	}
+
+	// or sent with their recorded signatures, which this bot rejects
+	received = nil
+	err := ReplayTo(ctx, srv.Client(), srv.URL, ds, ReplayOptions{})
+	if err == nil || !strings.Contains(err.Error(), "delivery 1: 400") {
+		t.Errorf("error = %v", err)
+	}
+	if len(received) != 0 {
+		t.Errorf("received %v", received)
+	}
+
+	// a bot with the recorded secret accepts them as they are
+	recorded := New(Config{WebHookSecret: "recorded"})
+	srv2 := httptest.NewServer(recorded.Handler())
+	defer srv2.Close()
+	if err := ReplayTo(ctx, nil, srv2.URL, ds, ReplayOptions{}); err != nil {
+		t.Errorf("%+v", err)
+	}
+}
@@ -0,0 +1,366 @@
+package ghbot
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// redisBlockTimeout bounds how long a blocking read waits, so that Dequeue
+// notices a done context and expired deliveries in time.
+const redisBlockTimeout = time.Second
+
+// RedisOptions configures a RedisTransport.
+type RedisOptions struct {
+	// Addr is the "host:port" of the Redis server.
+	Addr     string
+	Password string
+	DB       int
+	// Stream is the key of the stream, "ghbot:deliveries" by default.
+	Stream string
+	// Group is the consumer group shared by the workers, "ghbot" by
+	// default.
+	Group string
+	// Consumer names the worker in the group, by default after the host
+	// and the process.
+	Consumer string
+	// VisibilityTimeout is how long a delivery may be pending before
+	// another worker claims it, five minutes by default.
+	VisibilityTimeout time.Duration
+}
+
+// RedisTransport queues deliveries in a Redis stream, read by workers
+// through a consumer group. Deliveries are added with XADD, read with
+// XREADGROUP and acknowledged and deleted with XACK and XDEL. Deliveries
+// pending for longer than the visibility timeout are claimed with
+// XAUTOCLAIM, which needs Redis 6.2 or later.
+type RedisTransport struct {
+	opts RedisOptions
+
+	mu          sync.Mutex
+	idle        []*redisConn
+	groupExists bool
+}
+
+func NewRedisTransport(opts RedisOptions) *RedisTransport {
+	if opts.Stream == "" {
+		opts.Stream = "ghbot:deliveries"
+	}
+	if opts.Group == "" {
+		opts.Group = "ghbot"
+	}
+	if opts.Consumer == "" {
+		host, _ := os.Hostname()
+		opts.Consumer = fmt.Sprintf("%s-%d", host, os.Getpid())
+	}
+	if opts.VisibilityTimeout <= 0 {
+		opts.VisibilityTimeout = defaultVisibilityTimeout
+	}
+	return &RedisTransport{opts: opts}
+}
+
+// Close closes the idle connections.
+func (t *RedisTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, c := range t.idle {
+		c.conn.Close()
+	}
+	t.idle = nil
+	return nil
+}
+
+func (t *RedisTransport) Enqueue(ctx context.Context, d *RecordedDelivery) error {
+	b, err := marshalDelivery(d)
+	if err != nil {
+		return err
+	}
+	if _, err := t.do(ctx, 0, "XADD", t.opts.Stream, "*", "delivery", string(b)); err != nil {
+		return xerrors.Errorf("error enqueueing delivery: %w", err)
+	}
+	return nil
+}
+
+func (t *RedisTransport) Dequeue(ctx context.Context) (*QueuedDelivery, error) {
+	if err := t.createGroup(ctx); err != nil {
+		return nil, err
+	}
+	for {
+		q, err := t.claimExpired(ctx)
+		if err != nil || q != nil {
+			return q, err
+		}
+		block := redisBlockTimeout
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < block {
+			block = time.Until(deadline)
+		}
+		if block <= 0 {
+			return nil, ctx.Err()
+		}
+		reply, err := t.do(ctx, block, "XREADGROUP", "GROUP", t.opts.Group, t.opts.Consumer,
+			"COUNT", "1", "BLOCK", strconv.FormatInt(int64(block/time.Millisecond), 10),
+			"STREAMS", t.opts.Stream, ">")
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, xerrors.Errorf("error reading stream: %w", err)
+		}
+		// [[stream, [[id, [field, value, ...]]]]], or nil on timeout
+		if streams, ok := reply.([]interface{}); ok && len(streams) > 0 {
+			if stream, ok := streams[0].([]interface{}); ok && len(stream) == 2 {
+				if entries, ok := stream[1].([]interface{}); ok && len(entries) > 0 {
+					return t.queuedDelivery(ctx, entries[0], 1)
+				}
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *RedisTransport) createGroup(ctx context.Context) error {
+	t.mu.Lock()
+	exists := t.groupExists
+	t.mu.Unlock()
+	if exists {
+		return nil
+	}
+	_, err := t.do(ctx, 0, "XGROUP", "CREATE", t.opts.Stream, t.opts.Group, "0", "MKSTREAM")
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return xerrors.Errorf("error creating consumer group: %w", err)
+	}
+	t.mu.Lock()
+	t.groupExists = true
+	t.mu.Unlock()
+	return nil
+}
+
+// claimExpired claims a delivery pending for longer than the visibility
+// timeout, or returns nil when there is none.
+func (t *RedisTransport) claimExpired(ctx context.Context) (*QueuedDelivery, error) {
+	reply, err := t.do(ctx, 0, "XAUTOCLAIM", t.opts.Stream, t.opts.Group, t.opts.Consumer,
+		strconv.FormatInt(int64(t.opts.VisibilityTimeout/time.Millisecond), 10), "0-0", "COUNT", "1")
+	if err != nil {
+		return nil, xerrors.Errorf("error claiming pending deliveries: %w", err)
+	}
+	// [cursor, [[id, [field, value, ...]]], ...]
+	r, ok := reply.([]interface{})
+	if !ok || len(r) < 2 {
+		return nil, nil
+	}
+	entries, ok := r[1].([]interface{})
+	if !ok {
+		return nil, nil
+	}
+	for _, entry := range entries {
+		// entries deleted meanwhile are nil
+		if entry == nil {
+			continue
+		}
+		attempts, _ := t.pendingAttempts(ctx, redisEntryID(entry))
+		return t.queuedDelivery(ctx, entry, attempts)
+	}
+	return nil, nil
+}
+
+// pendingAttempts returns how often the pending entry id was delivered,
+// or false when it is not pending.
+func (t *RedisTransport) pendingAttempts(ctx context.Context, id string) (int, bool) {
+	// [[id, consumer, idle, deliveries]]
+	pending, err := t.do(ctx, 0, "XPENDING", t.opts.Stream, t.opts.Group, id, id, "1")
+	if err != nil {
+		return 0, false
+	}
+	if p, ok := pending.([]interface{}); ok && len(p) == 1 {
+		if fields, ok := p[0].([]interface{}); ok && len(fields) == 4 {
+			n, _ := fields[3].(int64)
+			return int(n), true
+		}
+	}
+	return 0, false
+}
+
+func redisEntryID(entry interface{}) string {
+	e, _ := entry.([]interface{})
+	if len(e) == 0 {
+		return ""
+	}
+	id, _ := e[0].(string)
+	return id
+}
+
+// queuedDelivery decodes a stream entry, [id, [field, value, ...]].
+// Entries which cannot be decoded are acknowledged and dropped, and
+// reported with an error wrapping ErrMalformedDelivery.
+func (t *RedisTransport) queuedDelivery(ctx context.Context, entry interface{}, attempts int) (*QueuedDelivery, error) {
+	id := redisEntryID(entry)
+	var body string
+	if e, ok := entry.([]interface{}); ok && len(e) == 2 {
+		fields, _ := e[1].([]interface{})
+		for i := 0; i+1 < len(fields); i += 2 {
+			if fields[i] == "delivery" {
+				body, _ = fields[i+1].(string)
+			}
+		}
+	}
+	d, err := unmarshalDelivery([]byte(body))
+	if err != nil {
+		t.Ack(ctx, &QueuedDelivery{MessageID: id, Attempts: attempts})
+		return nil, xerrors.Errorf("stream entry %s: %w", id, err)
+	}
+	return &QueuedDelivery{Delivery: d, MessageID: id, Attempts: attempts}, nil
+}
+
+func (t *RedisTransport) Ack(ctx context.Context, q *QueuedDelivery) error {
+	// a delivery claimed again meanwhile was delivered once more
+	if attempts, ok := t.pendingAttempts(ctx, q.MessageID); ok && attempts != q.Attempts {
+		return nil
+	}
+	if _, err := t.do(ctx, 0, "XACK", t.opts.Stream, t.opts.Group, q.MessageID); err != nil {
+		return xerrors.Errorf("error acknowledging delivery: %w", err)
+	}
+	if _, err := t.do(ctx, 0, "XDEL", t.opts.Stream, q.MessageID); err != nil {
+		return xerrors.Errorf("error deleting delivery: %w", err)
+	}
+	return nil
+}
+
+// redisError is an error reply of the server.
+type redisError string
+
+func (e redisError) Error() string { return string(e) }
+
+type redisConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// do sends a command on an idle connection, or a new one, and returns
+// the reply: a string, an int64, nil or a []interface{} of those. block
+// extends the deadline of blocking commands.
+func (t *RedisTransport) do(ctx context.Context, block time.Duration, args ...string) (interface{}, error) {
+	c, err := t.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(10*time.Second + block)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	c.conn.SetDeadline(deadline)
+	reply, err := c.do(args...)
+	if _, ok := err.(redisError); err != nil && !ok {
+		c.conn.Close()
+		// the connection may time out just before ctx does
+		if d, ok := ctx.Deadline(); ok && !time.Now().Before(d) {
+			return nil, context.DeadlineExceeded
+		}
+		return nil, err
+	}
+	t.mu.Lock()
+	t.idle = append(t.idle, c)
+	t.mu.Unlock()
+	return reply, err
+}
+
+func (t *RedisTransport) conn(ctx context.Context) (*redisConn, error) {
+	t.mu.Lock()
+	if n := len(t.idle); n > 0 {
+		c := t.idle[n-1]
+		t.idle = t.idle[:n-1]
+		t.mu.Unlock()
+		return c, nil
+	}
+	t.mu.Unlock()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", t.opts.Addr)
+	if err != nil {
+		return nil, xerrors.Errorf("error connecting to redis: %w", err)
+	}
+	c := &redisConn{conn: conn, r: bufio.NewReader(conn)}
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+	if t.opts.Password != "" {
+		if _, err := c.do("AUTH", t.opts.Password); err != nil {
+			conn.Close()
+			return nil, xerrors.Errorf("error authenticating to redis: %w", err)
+		}
+	}
+	if t.opts.DB != 0 {
+		if _, err := c.do("SELECT", strconv.Itoa(t.opts.DB)); err != nil {
+			conn.Close()
+			return nil, xerrors.Errorf("error selecting redis database: %w", err)
+		}
+	}
+	return c, nil
+}
+
+func (c *redisConn) do(args ...string) (interface{}, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := io.WriteString(c.conn, b.String()); err != nil {
+		return nil, err
+	}
+	return readRESP(c.r)
+}
+
+// readRESP reads a reply in the Redis serialization protocol.
+func readRESP(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || !strings.HasSuffix(line, "\r\n") {
+		return nil, xerrors.Errorf("malformed redis reply: %q", line)
+	}
+	kind, line := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return line, nil
+	case '-':
+		return nil, redisError(line)
+	case ':':
+		return strconv.ParseInt(line, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(line)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		elems := make([]interface{}, n)
+		for i := range elems {
+			if elems[i], err = readRESP(r); err != nil {
+				if _, ok := err.(redisError); !ok {
+					return nil, err
+				}
+				elems[i] = err
+			}
+		}
+		return elems, nil
+	}
+	return nil, xerrors.Errorf("malformed redis reply: %q", kind)
+}
@@ -0,0 +1,199 @@
+package ghbot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	defaultRelayMinBackoff = time.Second
+	defaultRelayMaxBackoff = time.Minute
+)
+
+// RelayOptions configures RunRelay.
+type RelayOptions struct {
+	// Client connects to the relay. Its timeout must be 0, since the
+	// stream of events never ends. http.DefaultClient when nil.
+	Client *http.Client
+	// MinBackoff and MaxBackoff bound the time waited before
+	// reconnecting, which doubles with every failed attempt. They default
+	// to a second and a minute.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// RunRelay receives webhook deliveries forwarded by a relay service which
+// streams them as Server-Sent Events, like smee.io, from the channel at
+// source until ctx is done. The deliveries are served by Handler with
+// their original headers, so that their signatures are checked as if
+// GitHub had sent them to the bot. RunRelay reconnects with exponential
+// backoff whenever the stream breaks.
+func (bot *Bot) RunRelay(ctx context.Context, source string, opts RelayOptions) error {
+	if err := bot.Validate(); err != nil {
+		return xerrors.Errorf("invalid configuration: %w", err)
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = defaultRelayMinBackoff
+	}
+	if opts.MaxBackoff < opts.MinBackoff {
+		opts.MaxBackoff = defaultRelayMaxBackoff
+		if opts.MaxBackoff < opts.MinBackoff {
+			opts.MaxBackoff = opts.MinBackoff
+		}
+	}
+
+	backoff := opts.MinBackoff
+	var lastEventID string
+	for {
+		received, err := bot.relayStream(ctx, source, opts.Client, &lastEventID)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if received {
+			backoff = opts.MinBackoff
+		}
+		bot.logger.Printf("relay connection to %s lost, reconnecting in %s: %+v", source, backoff, err)
+		if err := sleepContext(ctx, backoff); err != nil {
+			return err
+		}
+		if backoff *= 2; backoff > opts.MaxBackoff {
+			backoff = opts.MaxBackoff
+		}
+	}
+}
+
+// relayStream reads the event stream until it breaks, telling whether
+// anything was received.
+func (bot *Bot) relayStream(ctx context.Context, source string, client *http.Client, lastEventID *string) (bool, error) {
+	req, err := http.NewRequest(http.MethodGet, source, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	if *lastEventID != "" {
+		req.Header.Set("Last-Event-ID", *lastEventID)
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return false, xerrors.Errorf("error connecting to relay: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, xerrors.Errorf("error connecting to relay: %s", resp.Status)
+	}
+
+	received := false
+	r := bufio.NewReader(resp.Body)
+	var event, id string
+	var data bytes.Buffer
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return received, xerrors.Errorf("error reading relay stream: %w", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			// a blank line dispatches the event
+			if data.Len() > 0 {
+				received = true
+				if id != "" {
+					*lastEventID = id
+				}
+				if event == "" || event == "message" {
+					bot.relayDelivery(ctx, bytes.TrimSuffix(data.Bytes(), []byte("\n")))
+				}
+			}
+			event, id = "", ""
+			data.Reset()
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+		field, value := line, ""
+		if i := strings.Index(line, ":"); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+		switch field {
+		case "event":
+			event = value
+		case "data":
+			data.WriteString(value)
+			data.WriteByte('\n')
+		case "id":
+			id = value
+		}
+	}
+}
+
+// relayDelivery serves a delivery as forwarded by the relay: the headers
+// in lower case, the parsed body under "body", and the query and time of
+// the request under "query" and "timestamp". Form encoded deliveries are
+// dropped.
+func (bot *Bot) relayDelivery(ctx context.Context, data []byte) {
+	var msg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		bot.logger.Printf("error parsing relayed delivery: %+v", err)
+		return
+	}
+	header := http.Header{}
+	for k, v := range msg {
+		switch k {
+		case "body", "query", "timestamp":
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(v, &value); err != nil {
+			continue
+		}
+		header.Set(k, value)
+	}
+	// the relay parses form encoded bodies, so that their signatures
+	// cannot be checked against them anymore
+	if strings.HasPrefix(header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		bot.logger.Printf("dropping relayed delivery %s: form encoded deliveries cannot be relayed, set the content type of the webhook to application/json", header.Get("X-GitHub-Delivery"))
+		return
+	}
+	// keep the body as relayed, since reformatting it would break its
+	// signature
+	body := []byte(msg["body"])
+
+	r, err := http.NewRequest(http.MethodPost, "/webhook/github", bytes.NewReader(body))
+	if err != nil {
+		bot.logger.Printf("error relaying delivery: %+v", err)
+		return
+	}
+	r.Header = header
+	r.Header.Del("Content-Length")
+	w := relayResponse{header: http.Header{}, code: http.StatusOK}
+	bot.Handler().ServeHTTP(&w, r.WithContext(ctx))
+	if w.code/100 != 2 {
+		bot.logger.Printf("relayed delivery %s of %s event was answered with %d", header.Get("X-GitHub-Delivery"), header.Get("X-GitHub-Event"), w.code)
+	}
+}
+
+// relayResponse keeps the status the handler responded with to a relayed
+// delivery, which nobody waits for.
+type relayResponse struct {
+	header http.Header
+	code   int
+}
+
+func (w *relayResponse) Header() http.Header         { return w.header }
+func (w *relayResponse) Write(b []byte) (int, error) { return len(b), nil }
+func (w *relayResponse) WriteHeader(code int)        { w.code = code }
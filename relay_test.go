@@ -0,0 +1,132 @@
+package ghbot_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+	"github.com/nasa9084/ghbot"
+	"github.com/nasa9084/ghbot/ghbottest"
+	"golang.org/x/xerrors"
+)
+
+// failingTransport fails the next fail requests, as if the relay could
+// not be reached.
+type failingTransport struct {
+	mu   sync.Mutex
+	fail int
+}
+
+func (t *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	fail := t.fail > 0
+	if fail {
+		t.fail--
+	}
+	t.mu.Unlock()
+	if fail {
+		return nil, xerrors.New("connection refused")
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (t *failingTransport) failNext(n int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.fail = n
+}
+
+// TestRunRelay is in package ghbot_test, since the relay server of
+// ghbottest imports ghbot.
+func TestRunRelay(t *testing.T) {
+	srv := ghbottest.NewRelayServer()
+	defer srv.Close()
+
+	var logger logRecorder
+	bot := ghbot.New(ghbot.Config{WebHookSecret: "secret"})
+	bot.SetLogger(&logger)
+	handled := make(chan string, 10)
+	bot.AddPushEventHook(func(_ context.Context, e *github.PushEvent) error {
+		handled <- strings.TrimPrefix(e.GetRef(), "refs/heads/")
+		return nil
+	})
+
+	var tr failingTransport
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- bot.RunRelay(ctx, srv.URL, ghbot.RelayOptions{
+			Client:     &http.Client{Transport: &tr},
+			MinBackoff: 10 * time.Millisecond,
+			MaxBackoff: time.Second,
+		})
+	}()
+	expect := func(branch string) {
+		t.Helper()
+		select {
+		case got := <-handled:
+			if got != branch {
+				t.Errorf("handled push to %s, want %s", got, branch)
+			}
+		case err := <-done:
+			t.Fatalf("RunRelay returned %+v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("push to %s was not handled", branch)
+		}
+	}
+
+	// deliveries go through the signature check
+	srv.Publish(ghbottest.PushTo("a").Delivery(), "secret")
+	srv.Publish(ghbottest.PushTo("forged").Delivery(), "wrong")
+	// form encoded ones cannot be verified once the relay parsed them
+	form := ghbottest.PushTo("form").Delivery()
+	form.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	srv.Publish(form, "secret")
+	srv.Publish(ghbottest.PushTo("b").Delivery(), "secret")
+	expect("a")
+	expect("b")
+
+	// after the connection breaks, the bot reconnects and gets what was
+	// published meanwhile, but not the deliveries it already handled
+	tr.failNext(3)
+	srv.Disconnect()
+	srv.Publish(ghbottest.PushTo("c").Delivery(), "secret")
+	expect("c")
+
+	srv.Disconnect()
+	srv.Publish(ghbottest.PushTo("d").Delivery(), "secret")
+	expect("d")
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("RunRelay returned %+v, want context.Canceled", err)
+	}
+	if n := srv.Connections(); n != 3 {
+		t.Errorf("connected %d times, want 3", n)
+	}
+	if !logger.contains("answered with 400") {
+		t.Errorf("forged delivery was not rejected: %q", logger.lines)
+	}
+	if !logger.contains("dropping relayed delivery " + form.ID + ": form encoded") {
+		t.Errorf("form encoded delivery was not dropped: %q", logger.lines)
+	}
+
+	// the backoff doubles with every failed attempt, and is reset once
+	// the relay is reached
+	var backoffs []string
+	for _, line := range logger.lines {
+		if i := strings.Index(line, "reconnecting in "); i >= 0 {
+			backoffs = append(backoffs, strings.SplitN(line[i+len("reconnecting in "):], ":", 2)[0])
+		}
+	}
+	if want := []string{"10ms", "20ms", "40ms", "80ms", "10ms"}; !reflect.DeepEqual(backoffs, want) {
+		t.Errorf("reconnected after %v, want %v", backoffs, want)
+	}
+}